@@ -0,0 +1,9 @@
+data "neon_branch_databases" "example" {
+  project_id = "shiny-cell-31746257"
+  branch_id  = "br-snowy-mountain-a5jkb18i"
+  name_regex = "^app_"
+}
+
+output "databases" {
+  value = { for db in data.neon_branch_databases.example.databases : db.name => db.owner_name }
+}
@@ -0,0 +1,12 @@
+data "neon_branch_schema_diff" "example" {
+  project_id    = "curly-poetry-30604233"
+  branch_id     = "br-snowy-mountain-a5jkb18i"
+  database_name = "neondb"
+}
+
+check "schema" {
+  assert {
+    condition     = !data.neon_branch_schema_diff.example.has_changes
+    error_message = "The feature branch's schema diverges from its parent:\n${data.neon_branch_schema_diff.example.diff}"
+  }
+}
@@ -0,0 +1,8 @@
+# the most recently created CI branches of the project
+data "neon_branches" "ci" {
+  project_id = "shiny-cell-31746257"
+  search     = "ci-"
+  sort_by    = "created_at"
+  sort_order = "desc"
+  limit      = 100
+}
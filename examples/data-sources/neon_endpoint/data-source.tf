@@ -0,0 +1,8 @@
+data "neon_endpoint" "example" {
+  project_id  = "shiny-cell-31746257"
+  endpoint_id = "ep-dry-sun-a5m6c0ld"
+}
+
+output "max_compute_units" {
+  value = data.neon_endpoint.example.autoscaling_limit_max_cu
+}
@@ -0,0 +1,10 @@
+data "neon_limits" "this" {}
+
+resource "neon_project" "example" {
+  name = "foo"
+
+  default_endpoint_settings {
+    autoscaling_limit_min_cu = 0.25
+    autoscaling_limit_max_cu = data.neon_limits.this.max_autoscaling_limit_cu
+  }
+}
@@ -0,0 +1,6 @@
+data "neon_operations" "failed" {
+  project_id = "shiny-cell-31746257"
+  branch_id  = "br-snowy-mountain-a5jkb18i"
+  status     = "failed"
+  limit      = 10
+}
@@ -0,0 +1,7 @@
+data "neon_organization" "example" {
+  org_id = "org-morning-bread-81040908"
+}
+
+output "admins" {
+  value = [for m in data.neon_organization.example.members : m.email if m.role == "admin"]
+}
@@ -0,0 +1,10 @@
+data "neon_organization_consumption" "example" {
+  org_id      = "org-morning-bread-81040908"
+  from        = "2025-06-01T00:00:00Z"
+  to          = "2025-07-01T00:00:00Z"
+  granularity = "monthly"
+}
+
+output "written_data_bytes" {
+  value = data.neon_organization_consumption.example.total_written_data_bytes
+}
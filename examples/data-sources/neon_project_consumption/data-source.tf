@@ -0,0 +1,14 @@
+data "neon_project_consumption" "example" {
+  project_id  = "shiny-cell-31746257"
+  from        = "2025-06-01T00:00:00Z"
+  to          = "2025-07-01T00:00:00Z"
+  granularity = "daily"
+}
+
+check "compute_quota" {
+  assert {
+    # warn when 80% of the compute time quota of 100 hours is used
+    condition     = data.neon_project_consumption.example.total_compute_time_seconds < 0.8 * 100 * 3600
+    error_message = "The project used over 80% of its compute time quota."
+  }
+}
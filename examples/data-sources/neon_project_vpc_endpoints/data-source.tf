@@ -0,0 +1,3 @@
+data "neon_project_vpc_endpoints" "example" {
+  project_id = "shiny-cell-31746257"
+}
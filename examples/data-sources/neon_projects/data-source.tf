@@ -0,0 +1,11 @@
+data "neon_projects" "example" {
+  org_id    = "org-morning-bread-81040908"
+  region_id = "aws-us-east-2"
+}
+
+# share all projects of the organization in the region with the auditor
+resource "neon_project_permission" "auditor" {
+  for_each   = { for p in data.neon_projects.example.projects : p.id => p }
+  project_id = each.key
+  grantee    = "auditor@bar.qux"
+}
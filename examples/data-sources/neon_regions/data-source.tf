@@ -0,0 +1,5 @@
+data "neon_regions" "all" {}
+
+output "default_region" {
+  value = one([for r in data.neon_regions.all.regions : r.id if r.default])
+}
@@ -0,0 +1,11 @@
+data "neon_sql_query" "example" {
+  project_id = "shiny-cell-31746257"
+  branch_id  = "br-little-rain-a5c7ag5f"
+  database   = "neondb"
+  query      = "SELECT name, setting FROM pg_settings WHERE name = $1"
+  args       = ["max_connections"]
+}
+
+output "max_connections" {
+  value = data.neon_sql_query.example.rows[0]["setting"]
+}
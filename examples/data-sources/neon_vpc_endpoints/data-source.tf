@@ -0,0 +1,8 @@
+data "neon_vpc_endpoints" "example" {
+  org_id    = "org-morning-bread-81040908"
+  region_id = "aws-us-east-2"
+}
+
+output "pending_vpc_endpoints" {
+  value = [for v in data.neon_vpc_endpoints.example.vpc_endpoints : v.vpc_endpoint_id if v.state != "accepted"]
+}
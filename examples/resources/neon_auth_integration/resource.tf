@@ -0,0 +1,13 @@
+resource "neon_project" "example" {
+  name = "myproject"
+}
+
+# enable Neon Auth for the project's default branch
+resource "neon_auth_integration" "example" {
+  project_id    = neon_project.example.id
+  auth_provider = "stack"
+}
+
+output "jwks_url" {
+  value = neon_auth_integration.example.jwks_url
+}
@@ -25,3 +25,16 @@ resource "neon_branch" "child" {
   parent_id  = neon_branch.parent.id
   name       = "bar"
 }
+
+### restore a branch from a snapshot
+resource "neon_snapshot" "example" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  name       = "baz"
+}
+
+resource "neon_branch" "restored" {
+  project_id  = neon_project.example.id
+  snapshot_id = neon_snapshot.example.id
+  name        = "restored"
+}
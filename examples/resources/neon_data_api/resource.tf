@@ -0,0 +1,23 @@
+resource "neon_project" "example" {
+  name = "myproject"
+}
+
+resource "neon_branch" "preview" {
+  project_id = neon_project.example.id
+  name       = "preview"
+}
+
+# expose the database of the preview branch via the Data API
+# authenticated using the tokens issued by an external provider
+resource "neon_data_api" "example" {
+  project_id    = neon_project.example.id
+  branch_id     = neon_branch.preview.id
+  database_name = neon_project.example.database_name
+  auth_provider = "external"
+  provider_name = "Clerk"
+  jwks_url      = "https://foo.clerk.accounts.dev/.well-known/jwks.json"
+}
+
+output "data_api_url" {
+  value = neon_data_api.example.url
+}
@@ -18,4 +18,10 @@ resource "neon_database" "example" {
   branch_id  = neon_branch.example.id
   name       = "qux"
   owner_name = neon_role.example.name
+
+  settings = {
+    statement_timeout = "30s"
+    search_path       = "$user, public"
+    timezone          = "UTC"
+  }
 }
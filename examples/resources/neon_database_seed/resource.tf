@@ -0,0 +1,31 @@
+resource "neon_project" "example" {
+  name = "myproject"
+}
+
+# the preview branch created from the empty parent branch
+resource "neon_branch" "preview" {
+  project_id = neon_project.example.id
+  name       = "preview"
+}
+
+resource "neon_endpoint" "preview" {
+  project_id = neon_project.example.id
+  branch_id  = neon_branch.preview.id
+}
+
+# load the reference data generated by pg_dump --format=plain --data-only
+resource "neon_database_seed" "reference_data" {
+  project_id = neon_project.example.id
+  branch_id  = neon_endpoint.preview.branch_id
+  database   = neon_project.example.database_name
+  file       = "${path.module}/seed/reference_data.sql"
+
+  # load the data again when the endpoint is recreated
+  triggers = {
+    endpoint_id = neon_endpoint.preview.id
+  }
+}
+
+output "rows_loaded" {
+  value = neon_database_seed.reference_data.rows_loaded
+}
@@ -0,0 +1,16 @@
+resource "neon_project" "example" {
+  name                       = "foo"
+  enable_logical_replication = "yes"
+}
+
+# wait for the endpoints restart triggered by the logical replication activation
+resource "neon_operations_wait" "logical_replication" {
+  project_id = neon_project.example.id
+  triggers = {
+    enable_logical_replication = neon_project.example.enable_logical_replication
+  }
+
+  timeouts {
+    create = "10m"
+  }
+}
@@ -0,0 +1,5 @@
+resource "neon_organization_invitation" "example" {
+  org_id = "org-morning-bread-81040908"
+  email  = "foo@bar.qux"
+  role   = "member"
+}
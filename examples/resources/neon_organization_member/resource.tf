@@ -0,0 +1,6 @@
+# promote the user who has joined the organization to the admin
+resource "neon_organization_member" "example" {
+  org_id = "org-morning-bread-81040908"
+  email  = "foo@bar.qux"
+  role   = "admin"
+}
@@ -0,0 +1,21 @@
+resource "neon_project" "example" {
+  name = "myproject"
+}
+
+resource "neon_role" "reader" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  name       = "reader"
+}
+
+# allow the role to read the tables which will be created in the schema public by the database owner
+resource "neon_postgres_default_privileges" "example" {
+  project_id  = neon_project.example.id
+  branch_id   = neon_project.example.default_branch_id
+  database    = neon_project.example.database_name
+  role        = neon_role.reader.name
+  owner       = neon_project.example.database_user
+  object_type = "table"
+  schema      = "public"
+  privileges  = ["SELECT"]
+}
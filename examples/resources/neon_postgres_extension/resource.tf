@@ -0,0 +1,21 @@
+resource "neon_project" "example" {
+  name = "myproject"
+}
+
+# enable pgvector in the default database of the project
+resource "neon_postgres_extension" "vector" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  database   = neon_project.example.database_name
+  name       = "vector"
+}
+
+# enable PostGIS with its dependencies in the dedicated schema
+resource "neon_postgres_extension" "postgis" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  database   = neon_project.example.database_name
+  name       = "postgis_tiger_geocoder"
+  schema     = "tiger"
+  cascade    = true
+}
@@ -0,0 +1,30 @@
+resource "neon_project" "example" {
+  name = "myproject"
+}
+
+resource "neon_role" "reader" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  name       = "reader"
+}
+
+# allow the role to read all tables in the schema public
+resource "neon_postgres_grant" "usage" {
+  project_id  = neon_project.example.id
+  branch_id   = neon_project.example.default_branch_id
+  database    = neon_project.example.database_name
+  role        = neon_role.reader.name
+  object_type = "schema"
+  schema      = "public"
+  privileges  = ["USAGE"]
+}
+
+resource "neon_postgres_grant" "select" {
+  project_id  = neon_project.example.id
+  branch_id   = neon_project.example.default_branch_id
+  database    = neon_project.example.database_name
+  role        = neon_role.reader.name
+  object_type = "table"
+  schema      = "public"
+  privileges  = ["SELECT"]
+}
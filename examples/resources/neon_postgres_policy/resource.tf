@@ -0,0 +1,46 @@
+resource "neon_project" "example" {
+  name = "myproject"
+}
+
+resource "neon_endpoint" "example" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+}
+
+resource "neon_role" "authenticated" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  name       = "authenticated"
+}
+
+# map the users authenticated by Stack to the role "authenticated"
+resource "neon_jwks_url" "stack" {
+  project_id    = neon_project.example.id
+  role_names    = [neon_role.authenticated.name]
+  provider_name = "Stack"
+  jwks_url      = "https://api.stack-auth.com/api/v1/projects/e3475923-a0b3-4cbb-a70f-b3071985a11d/.well-known/jwks.json"
+}
+
+# allow the authenticated users to manage their own todos
+resource "neon_postgres_policy" "todos_owner" {
+  project_id = neon_project.example.id
+  branch_id  = neon_endpoint.example.branch_id
+  database   = neon_project.example.database_name
+  name       = "todos_owner"
+  table      = "public.todos"
+  roles      = [neon_role.authenticated.name]
+  using      = "user_id = auth.user_id()"
+  with_check = "user_id = auth.user_id()"
+}
+
+# forbid the deletion of the archived todos
+resource "neon_postgres_policy" "todos_archived" {
+  project_id = neon_project.example.id
+  branch_id  = neon_endpoint.example.branch_id
+  database   = neon_project.example.database_name
+  name       = "todos_archived"
+  table      = "public.todos"
+  command    = "DELETE"
+  permissive = false
+  using      = "NOT archived"
+}
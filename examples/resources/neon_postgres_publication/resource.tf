@@ -0,0 +1,23 @@
+resource "neon_project" "example" {
+  name                       = "myproject"
+  enable_logical_replication = true
+}
+
+# publish the changes of all tables for the CDC pipeline
+resource "neon_postgres_publication" "cdc" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  database   = neon_project.example.database_name
+  name       = "cdc"
+  all_tables = true
+}
+
+# publish the inserts and updates of the selected tables
+resource "neon_postgres_publication" "users" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  database   = neon_project.example.database_name
+  name       = "users"
+  tables     = ["public.users", "public.accounts"]
+  publish    = ["insert", "update"]
+}
@@ -0,0 +1,11 @@
+resource "neon_project" "example" {
+  name                       = "myproject"
+  enable_logical_replication = true
+}
+
+resource "neon_postgres_replication_slot" "cdc" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  database   = neon_project.example.database_name
+  name       = "cdc"
+}
@@ -0,0 +1,18 @@
+resource "neon_project" "example" {
+  name = "myproject"
+}
+
+resource "neon_role" "tenant" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  name       = "tenant_foo"
+}
+
+# dedicated schema owned by the tenant's role
+resource "neon_postgres_schema" "tenant" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  database   = neon_project.example.database_name
+  name       = "tenant_foo"
+  owner      = neon_role.tenant.name
+}
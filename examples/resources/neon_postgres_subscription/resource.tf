@@ -0,0 +1,27 @@
+resource "neon_project" "source" {
+  name                       = "source"
+  enable_logical_replication = true
+}
+
+resource "neon_project" "destination" {
+  name = "destination"
+}
+
+resource "neon_postgres_publication" "source" {
+  project_id = neon_project.source.id
+  branch_id  = neon_project.source.default_branch_id
+  database   = neon_project.source.database_name
+  name       = "users"
+  tables     = ["public.users"]
+}
+
+resource "neon_postgres_subscription" "destination" {
+  project_id        = neon_project.destination.id
+  branch_id         = neon_project.destination.default_branch_id
+  database          = neon_project.destination.database_name
+  name              = "users"
+  source_project_id = neon_project.source.id
+  source_branch_id  = neon_project.source.default_branch_id
+  source_database   = neon_project.source.database_name
+  publications      = [neon_postgres_publication.source.name]
+}
@@ -0,0 +1,12 @@
+resource "neon_project" "example" {
+  name = "foo"
+}
+
+# grant project access to exactly the listed users, and revoke it from everybody else
+resource "neon_project_permissions" "share" {
+  project_id = neon_project.example.id
+  grantees = [
+    "foo@bar.qux",
+    "baz@bar.qux",
+  ]
+}
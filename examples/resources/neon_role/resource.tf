@@ -12,3 +12,15 @@ resource "neon_role" "example" {
   branch_id  = neon_branch.example.id
   name       = "qux"
 }
+
+# read-only role with the attributes managed via SQL
+resource "neon_role" "readonly" {
+  project_id       = neon_project.example.id
+  branch_id        = neon_branch.example.id
+  name             = "readonly"
+  login            = true
+  createdb         = false
+  createrole       = false
+  connection_limit = 10
+  search_path      = ["public"]
+}
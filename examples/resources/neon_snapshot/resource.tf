@@ -0,0 +1,26 @@
+resource "neon_project" "example" {
+  name = "foo"
+}
+
+### take a snapshot of the default branch
+resource "neon_snapshot" "example" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  name       = "bar"
+}
+
+### take a snapshot at the given point in time which expires automatically
+resource "neon_snapshot" "point_in_time" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  name       = "qux"
+  timestamp  = 1767225600 # 2026-01-01T00:00:00Z
+  expires_at = 1798761600 # 2027-01-01T00:00:00Z
+}
+
+### restore the snapshot to a new branch
+resource "neon_branch" "restored" {
+  project_id  = neon_project.example.id
+  name        = "restored"
+  snapshot_id = neon_snapshot.example.id
+}
@@ -0,0 +1,33 @@
+resource "neon_project" "example" {
+  name = "myproject"
+}
+
+resource "neon_branch" "preview" {
+  project_id = neon_project.example.id
+  name       = "preview"
+}
+
+resource "neon_endpoint" "preview" {
+  project_id = neon_project.example.id
+  branch_id  = neon_branch.preview.id
+}
+
+# bootstrap the schema of the preview branch
+resource "neon_sql_migrations" "preview" {
+  project_id = neon_project.example.id
+  branch_id  = neon_endpoint.preview.branch_id
+  database   = neon_project.example.database_name
+  dir        = "${path.module}/migrations"
+}
+
+# verify the migrations on the temporary child branch of the default branch
+resource "neon_sql_migrations" "dry_run" {
+  project_id = neon_project.example.id
+  branch_id  = neon_project.example.default_branch_id
+  database   = neon_project.example.database_name
+  files = [
+    "${path.module}/migrations/0001_init.sql",
+    "${path.module}/migrations/0002_users.sql",
+  ]
+  dry_run = true
+}
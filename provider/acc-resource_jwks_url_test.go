@@ -90,9 +90,14 @@ resource "neon_jwks_url" "_" {
 						Config:       config,
 						ResourceName: resourceName,
 						ImportState:  true,
-						ExpectError: regexp.MustCompile(
-							"the resource does not support import, please recreate it instead",
-						),
+						ImportStateIdFunc: func(s *terraform.State) (string, error) {
+							r, ok := s.RootModule().Resources[resourceName]
+							if !ok {
+								return "", errors.New("resource not found: " + resourceName)
+							}
+							return r.Primary.Attributes["project_id"] + "/" + r.Primary.ID, nil
+						},
+						ImportStateVerify: true,
 					},
 					// shall yield non-empty plan if the resource is deleted outside terraform
 					// given that JWKs existed prior to deletion
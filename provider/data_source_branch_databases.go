@@ -0,0 +1,104 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func dataSourceBranchDatabases() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Fetch Branch Databases.",
+		SchemaVersion: 1,
+		ReadContext:   dataSourceBranchDatabasesRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project ID.",
+			},
+			"branch_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Branch ID.",
+			},
+			"name_regex": schemaNameRegex,
+			"databases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Database ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Database name.",
+						},
+						"owner_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Role name of the database owner.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Database creation timestamp.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceBranchDatabasesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read Databases")
+
+	projectID := d.Get("project_id").(string)
+	branchID := d.Get("branch_id").(string)
+
+	d.SetId(fmt.Sprintf("%s/%s/databases", projectID, branchID))
+
+	filter, err := newNameFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := meta.(sdkBranchDatabases).ListProjectBranchDatabases(projectID, branchID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var databases []map[string]interface{}
+	for _, v := range resp.Databases {
+		if !filter(v.Name) {
+			continue
+		}
+
+		databases = append(databases, map[string]interface{}{
+			"id":         int(v.ID),
+			"name":       v.Name,
+			"owner_name": v.OwnerName,
+			"created_at": v.CreatedAt.Format(time.RFC3339),
+		})
+	}
+
+	if err := d.Set("databases", databases); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
+
+type sdkBranchDatabases interface {
+	ListProjectBranchDatabases(projectID string, branchID string) (neon.DatabasesResponse, error)
+}
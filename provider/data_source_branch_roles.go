@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -26,6 +28,7 @@ func dataSourceBranchRoles() *schema.Resource {
 				Required:    true,
 				Description: "Branch ID.",
 			},
+			"name_regex": schemaNameRegex,
 			"roles": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -40,6 +43,17 @@ func dataSourceBranchRoles() *schema.Resource {
 							Type:     schema.TypeBool,
 							Computed: true,
 						},
+						"authentication_method": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `Role's authentication method: "jwks" if the role is mapped to a JWKS URL,
+"password" otherwise.`,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Role creation timestamp.",
+						},
 					},
 				},
 			},
@@ -55,25 +69,41 @@ func dataSourceBranchRolesRead(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(fmt.Sprintf("%s/%s/roles", projectID, branchID))
 
-	resp, err := meta.(*neon.Client).ListProjectBranchRoles(
+	filter, err := newNameFilter(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := meta.(sdkBranchRoles)
+	resp, err := client.ListProjectBranchRoles(
 		projectID,
 		branchID,
 	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
+	jwks, err := client.GetProjectJWKS(projectID)
 	if err != nil {
-		diag.FromErr(err)
+		return diag.FromErr(err)
 	}
 
 	var roles []map[string]interface{}
 	for _, v := range resp.Roles {
+		if !filter(v.Name) {
+			continue
+		}
+
 		protected := true
 		if v.Protected != nil {
 			protected = *v.Protected
 		}
 
 		roles = append(roles, map[string]interface{}{
-			"name":      v.Name,
-			"protected": protected,
+			"name":                  v.Name,
+			"protected":             protected,
+			"authentication_method": roleAuthenticationMethod(jwks.Jwks, branchID, v.Name),
+			"created_at":            v.CreatedAt.Format(time.RFC3339),
 		})
 	}
 
@@ -83,3 +113,21 @@ func dataSourceBranchRolesRead(ctx context.Context, d *schema.ResourceData, meta
 
 	return diag.FromErr(nil)
 }
+
+// roleAuthenticationMethod defines the role's authentication method based on the project's JWKS mapping.
+func roleAuthenticationMethod(jwks []neon.JWKS, branchID, roleName string) string {
+	for _, v := range jwks {
+		if v.BranchID != nil && *v.BranchID != branchID {
+			continue
+		}
+		if v.RoleNames != nil && slices.Contains(*v.RoleNames, roleName) {
+			return "jwks"
+		}
+	}
+	return "password"
+}
+
+type sdkBranchRoles interface {
+	ListProjectBranchRoles(projectID string, branchID string) (neon.RolesResponse, error)
+	GetProjectJWKS(projectID string) (neon.ProjectJWKSResponse, error)
+}
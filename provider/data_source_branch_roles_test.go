@@ -0,0 +1,56 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"testing"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_roleAuthenticationMethod(t *testing.T) {
+	jwks := []neon.JWKS{
+		{ID: "project-wide", RoleNames: &[]string{"authenticated"}},
+		{ID: "branch-specific", BranchID: pointer("br-foo"), RoleNames: &[]string{"anonymous"}},
+	}
+
+	tests := []struct {
+		name     string
+		branchID string
+		roleName string
+		want     string
+	}{
+		{
+			name:     "role mapped to the project-wide JWKS",
+			branchID: "br-bar",
+			roleName: "authenticated",
+			want:     "jwks",
+		},
+		{
+			name:     "role mapped to the branch JWKS",
+			branchID: "br-foo",
+			roleName: "anonymous",
+			want:     "jwks",
+		},
+		{
+			name:     "role mapped to the JWKS of another branch",
+			branchID: "br-bar",
+			roleName: "anonymous",
+			want:     "password",
+		},
+		{
+			name:     "role not mapped to JWKS",
+			branchID: "br-foo",
+			roleName: "owner",
+			want:     "password",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := roleAuthenticationMethod(jwks, tt.branchID, tt.roleName); got != tt.want {
+				t.Errorf("roleAuthenticationMethod() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func dataSourceBranchSchemaDiff() *schema.Resource {
+	return &schema.Resource{
+		Description: `Fetch the database schema difference between two branches.
+See details: https://neon.com/docs/guides/schema-diff`,
+		SchemaVersion: 1,
+		ReadContext:   dataSourceBranchSchemaDiffRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project ID.",
+			},
+			"branch_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the branch to compare.",
+			},
+			"base_branch_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: `ID of the branch to compare against.
+The parent of the branch ` + "`branch_id`" + ` is used if not set.`,
+			},
+			"database_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the database to compare.",
+			},
+			"lsn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Log Sequence Number (LSN) of the branch " + "`branch_id`" + " to compare.",
+			},
+			"base_lsn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Log Sequence Number (LSN) of the base branch to compare against.",
+			},
+			"diff": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Schema difference in the unified diff format of the DDL statements.",
+			},
+			"has_changes": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Flag indicating that the schemas differ.",
+			},
+		},
+	}
+}
+
+func dataSourceBranchSchemaDiffRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read Branch Schema Diff")
+
+	projectID := d.Get("project_id").(string)
+	branchID := d.Get("branch_id").(string)
+	dbName := d.Get("database_name").(string)
+
+	client := meta.(sdkBranchSchemaDiff)
+	branches, err := listProjectBranches(client, projectID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	baseBranchID, err := resolveBaseBranchID(branches, branchID, d.Get("base_branch_id").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var lsn, baseLsn *string
+	if v, ok := d.GetOk("lsn"); ok && v.(string) != "" {
+		lsn = pointer(v.(string))
+	}
+	if v, ok := d.GetOk("base_lsn"); ok && v.(string) != "" {
+		baseLsn = pointer(v.(string))
+	}
+
+	resp, err := client.GetProjectBranchSchemaComparison(
+		projectID, branchID, &baseBranchID, dbName, lsn, nil, baseLsn, nil,
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s/%s/schema_diff", projectID, baseBranchID, branchID, dbName))
+
+	var diff string
+	if resp.Diff != nil {
+		diff = *resp.Diff
+	}
+
+	if err := d.Set("base_branch_id", baseBranchID); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("diff", diff); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("has_changes", diff != ""); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
+
+// resolveBaseBranchID returns the base branch ID if it's set and exists in the project,
+// or the ID of the parent of the branch branchID otherwise.
+func resolveBaseBranchID(branches []neon.Branch, branchID, baseBranchID string) (string, error) {
+	branch, ok := findBranch(branches, branchID)
+	if !ok {
+		return "", errors.New("branch " + branchID + " not found")
+	}
+
+	if baseBranchID != "" {
+		if _, ok := findBranch(branches, baseBranchID); !ok {
+			return "", errors.New("base branch " + baseBranchID + " not found")
+		}
+		return baseBranchID, nil
+	}
+
+	if branch.ParentID == nil || *branch.ParentID == "" {
+		return "", errors.New("branch " + branchID + " has no parent, base_branch_id must be set")
+	}
+	return *branch.ParentID, nil
+}
+
+type sdkBranchSchemaDiff interface {
+	sdkBranches
+	GetProjectBranchSchemaComparison(projectID string, branchID string, baseBranchID *string, dbName string,
+		lsn *string, timestamp *time.Time, baseLsn *string, baseTimestamp *time.Time,
+	) (neon.BranchSchemaCompareResponse, error)
+}
@@ -0,0 +1,65 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"testing"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_resolveBaseBranchID(t *testing.T) {
+	branches := []neon.Branch{
+		{ID: "br-main"},
+		{ID: "br-feature", ParentID: pointer("br-main")},
+		{ID: "br-other", ParentID: pointer("br-main")},
+	}
+
+	tests := []struct {
+		name         string
+		branchID     string
+		baseBranchID string
+		want         string
+		wantErr      bool
+	}{
+		{
+			name:     "shall default to the parent branch",
+			branchID: "br-feature",
+			want:     "br-main",
+		},
+		{
+			name:         "shall use the base branch if set",
+			branchID:     "br-feature",
+			baseBranchID: "br-other",
+			want:         "br-other",
+		},
+		{
+			name:     "unhappy path: branch not found",
+			branchID: "br-missing",
+			wantErr:  true,
+		},
+		{
+			name:         "unhappy path: base branch not found",
+			branchID:     "br-feature",
+			baseBranchID: "br-missing",
+			wantErr:      true,
+		},
+		{
+			name:     "unhappy path: root branch without base branch",
+			branchID: "br-main",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveBaseBranchID(branches, tt.branchID, tt.baseBranchID)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveBaseBranchID() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("resolveBaseBranchID() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
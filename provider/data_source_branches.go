@@ -2,7 +2,10 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -21,6 +24,43 @@ func dataSourceBranches() *schema.Resource {
 				Required:    true,
 				Description: "Project ID.",
 			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Search query by the branch name or ID. Partial match is supported.",
+			},
+			"sort_by": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Attribute to sort the branches by. Allowed values: "name", "created_at", "updated_at".`,
+				ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+					switch v := i.(string); v {
+					case "name", "created_at", "updated_at":
+					default:
+						errs = append(errs, errors.New(v+" is not supported value for "+s))
+					}
+					return
+				},
+			},
+			"sort_order": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: `Sort order. Allowed values: "asc", "desc".`,
+				ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+					switch v := i.(string); v {
+					case "asc", "desc":
+					default:
+						errs = append(errs, errors.New(v+" is not supported value for "+s))
+					}
+					return
+				},
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of branches to fetch. All branches are fetched if not set.",
+				ValidateFunc: intValidationNotNegative,
+			},
 			"branches": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -53,6 +93,36 @@ func dataSourceBranches() *schema.Resource {
 							Computed:    true,
 							Description: "Primary branch flag.",
 						},
+						"default": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Default branch flag.",
+						},
+						"protected": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Protected branch flag.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Branch creation timestamp.",
+						},
+						"current_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Current state of the branch.",
+						},
+						"expires_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp when the branch is scheduled to expire. Empty if the branch never expires.",
+						},
+						"compute_time_seconds": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Compute time used by the branch in seconds.",
+						},
 					},
 				},
 			},
@@ -67,14 +137,18 @@ func dataSourceBranchesRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(fmt.Sprintf("%s/branches", projectID))
 
-	// TODO: add search qualifier for branches
-	resp, err := meta.(*neon.Client).ListProjectBranches(projectID, nil, nil, nil, nil, nil)
+	resp, err := listProjectBranchesWithQuery(meta.(sdkBranches), projectID, branchesQuery{
+		Search:    d.Get("search").(string),
+		SortBy:    d.Get("sort_by").(string),
+		SortOrder: d.Get("sort_order").(string),
+		Limit:     d.Get("limit").(int),
+	})
 	if err != nil {
-		diag.FromErr(err)
+		return diag.FromErr(err)
 	}
 
 	var branches []map[string]interface{}
-	for _, v := range resp.Branches {
+	for _, v := range resp {
 		parentID := ""
 		if v.ParentID != nil {
 			parentID = *v.ParentID
@@ -84,12 +158,23 @@ func dataSourceBranchesRead(ctx context.Context, d *schema.ResourceData, meta in
 			logicalSize = *v.LogicalSize
 		}
 
+		expiresAt := ""
+		if v.ExpiresAt != nil {
+			expiresAt = v.ExpiresAt.Format(time.RFC3339)
+		}
+
 		branches = append(branches, map[string]interface{}{
-			"id":           v.ID,
-			"name":         v.Name,
-			"parent_id":    parentID,
-			"logical_size": logicalSize,
-			"primary":      v.Primary,
+			"id":                   v.ID,
+			"name":                 v.Name,
+			"parent_id":            parentID,
+			"logical_size":         logicalSize,
+			"primary":              v.Primary,
+			"default":              v.Default,
+			"protected":            v.Protected,
+			"created_at":           v.CreatedAt.Format(time.RFC3339),
+			"current_state":        string(v.CurrentState),
+			"expires_at":           expiresAt,
+			"compute_time_seconds": v.ComputeTimeSeconds,
 		})
 	}
 
@@ -99,3 +184,57 @@ func dataSourceBranchesRead(ctx context.Context, d *schema.ResourceData, meta in
 
 	return diag.FromErr(nil)
 }
+
+type sdkBranches interface {
+	ListProjectBranches(string, *string, *string, *string, *string, *int) (neon.ListProjectBranchesRespObj, error)
+}
+
+func listProjectBranches(c sdkBranches, projectID string) ([]neon.Branch, error) {
+	return listProjectBranchesWithQuery(c, projectID, branchesQuery{})
+}
+
+// branchesQuery defines the query to list the branches. Zero values are omitted.
+type branchesQuery struct {
+	Search, SortBy, SortOrder string
+	// Limit defines the max number of branches to fetch, all branches are fetched if zero.
+	Limit int
+}
+
+// listProjectBranchesWithQuery reads the branches page by page following the pagination cursor.
+func listProjectBranchesWithQuery(c sdkBranches, projectID string, q branchesQuery) ([]neon.Branch, error) {
+	var (
+		o      []neon.Branch
+		cursor *string
+	)
+	for {
+		var limit *int
+		if q.Limit > 0 {
+			limit = pointer(q.Limit - len(o))
+		}
+
+		// the SDK does not escape the query's values
+		resp, err := c.ListProjectBranches(
+			projectID, pointer(url.QueryEscape(q.Search)), pointer(q.SortBy), cursor, pointer(q.SortOrder), limit,
+		)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, resp.Branches...)
+
+		if len(resp.Branches) == 0 || (q.Limit > 0 && len(o) >= q.Limit) ||
+			resp.Pagination == nil || resp.Pagination.Next == nil || *resp.Pagination.Next == "" ||
+			(cursor != nil && *cursor == *resp.Pagination.Next) {
+			return o, nil
+		}
+		cursor = resp.Pagination.Next
+	}
+}
+
+func findBranch(branches []neon.Branch, branchID string) (neon.Branch, bool) {
+	for _, v := range branches {
+		if v.ID == branchID {
+			return v, true
+		}
+	}
+	return neon.Branch{}, false
+}
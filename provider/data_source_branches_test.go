@@ -0,0 +1,90 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_listProjectBranchesWithQuery(t *testing.T) {
+	t.Parallel()
+
+	var branches = make([]neon.Branch, 5)
+	for i := range branches {
+		branches[i] = neon.Branch{ID: fmt.Sprintf("br-%d", i)}
+	}
+
+	tests := []struct {
+		name         string
+		pageSize     int
+		limit        int
+		want         int
+		wantRequests int
+	}{
+		{
+			name:         "shall fetch all branches",
+			want:         5,
+			wantRequests: 1,
+		},
+		{
+			name:         "shall fetch the limited number of branches",
+			limit:        2,
+			want:         2,
+			wantRequests: 1,
+		},
+		{
+			name:         "shall not exceed the number of existing branches",
+			limit:        10,
+			want:         5,
+			wantRequests: 1,
+		},
+		{
+			name:         "shall fetch all pages",
+			pageSize:     2,
+			want:         5,
+			wantRequests: 3,
+		},
+		{
+			name:         "shall stop paging when the limit is reached",
+			pageSize:     2,
+			limit:        3,
+			want:         3,
+			wantRequests: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &sdkClientStub{stubBranches: stubBranches{Branches: branches, PageSize: tt.pageSize}}
+
+			got, err := listProjectBranchesWithQuery(client, "foo", branchesQuery{Limit: tt.limit})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != tt.want {
+				t.Errorf("unexpected number of branches: want=%d, got=%d", tt.want, len(got))
+			}
+			if client.branchesRequests != tt.wantRequests {
+				t.Errorf("unexpected number of requests: want=%d, got=%d", tt.wantRequests, client.branchesRequests)
+			}
+		})
+	}
+
+	t.Run("shall pass the escaped search and the sorting", func(t *testing.T) {
+		client := &sdkClientStub{stubBranches: stubBranches{Branches: branches}}
+
+		if _, err := listProjectBranchesWithQuery(client, "foo", branchesQuery{
+			Search: "dev & qa #1+2", SortBy: "name", SortOrder: "asc",
+		}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		want := branchesQuery{Search: "dev+%26+qa+%231%2B2", SortBy: "name", SortOrder: "asc"}
+		if client.branchesQuery != want {
+			t.Errorf("unexpected query: want=%+v, got=%+v", want, client.branchesQuery)
+		}
+	})
+}
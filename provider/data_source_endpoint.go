@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func dataSourceEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Fetch Endpoint.",
+		SchemaVersion: 1,
+		ReadContext:   dataSourceEndpointRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project ID.",
+			},
+			"endpoint_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Endpoint ID.",
+			},
+			"branch_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Branch ID.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Access type.",
+			},
+			"host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Endpoint URI. It points to the pooler if the connection pooling is activated.",
+			},
+			"pooled_host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Endpoint URI with the traffic via pooler.",
+			},
+			"region_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Deployment region: https://neon.tech/docs/introduction/regions",
+			},
+			"autoscaling_limit_min_cu": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Minimum number of Compute Units.",
+			},
+			"autoscaling_limit_max_cu": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Maximum number of Compute Units.",
+			},
+			"pg_settings": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Postgres settings.",
+			},
+			"pooler_enabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Connection pooling flag.",
+			},
+			"pooler_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Mode of connections pooling.",
+			},
+			"disabled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Disabled endpoint flag.",
+			},
+			"proxy_host": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"compute_provisioner": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Neon compute provisioner.",
+			},
+			"suspend_timeout_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+				Description: `Duration of inactivity in seconds after which the compute endpoint is automatically suspended.
+The value 0 means use the global default. The value -1 means never suspend.`,
+			},
+			"current_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Current state of the endpoint.",
+			},
+		},
+	}
+}
+
+func dataSourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read Endpoint")
+
+	projectID := d.Get("project_id").(string)
+	endpointID := d.Get("endpoint_id").(string)
+
+	resp, err := meta.(sdkEndpoint).GetProjectEndpoint(projectID, endpointID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(projectID + "/" + endpointID)
+
+	if err := updateStateEndpoint(d, resp.Endpoint); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("pooled_host", newPooledHost(resp.Endpoint.Host)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("current_state", string(resp.Endpoint.CurrentState)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
+
+type sdkEndpoint interface {
+	GetProjectEndpoint(projectID string, endpointID string) (neon.EndpointResponse, error)
+}
@@ -0,0 +1,77 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_dataSourceEndpointRead(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	meta := &sdkClientStub{
+		stubEndpoint: stubEndpoint{
+			Endpoint: neon.Endpoint{
+				ID:                    "ep-foo",
+				BranchID:              "br-bar",
+				Host:                  "ep-foo.us-east-2.aws.neon.tech",
+				AutoscalingLimitMinCu: 0.25,
+				AutoscalingLimitMaxCu: 2,
+				PoolerEnabled:         true,
+				PoolerMode:            "transaction",
+				CurrentState:          "idle",
+				Type:                  endpointTypeRW,
+			},
+		},
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		d := dataSourceEndpoint().TestResourceData()
+		_ = d.Set("project_id", "myproject")
+		_ = d.Set("endpoint_id", "ep-foo")
+
+		if diags := dataSourceEndpointRead(context.TODO(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags[0].Summary)
+		}
+
+		if d.Id() != "myproject/ep-foo" {
+			t.Errorf("unexpected ID: %s", d.Id())
+		}
+
+		const wantHost = "ep-foo-pooler.us-east-2.aws.neon.tech"
+		if v := d.Get("pooled_host").(string); v != wantHost {
+			t.Errorf("unexpected pooled_host: want=%s, got=%s", wantHost, v)
+		}
+		if v := d.Get("branch_id").(string); v != "br-bar" {
+			t.Errorf("unexpected branch_id: %s", v)
+		}
+		if v := d.Get("autoscaling_limit_max_cu").(float64); v != 2 {
+			t.Errorf("unexpected autoscaling_limit_max_cu: %v", v)
+		}
+		if v := d.Get("current_state").(string); v != "idle" {
+			t.Errorf("unexpected current_state: %s", v)
+		}
+	})
+
+	t.Run("unhappy path: endpoint not found", func(t *testing.T) {
+		d := dataSourceEndpoint().TestResourceData()
+		_ = d.Set("project_id", "myproject")
+		_ = d.Set("endpoint_id", "ep-missing")
+
+		if diags := dataSourceEndpointRead(context.TODO(), d, meta); !diags.HasError() {
+			t.Fatal("error expected")
+		}
+		if d.Id() != "" {
+			t.Errorf("unexpected ID: %s", d.Id())
+		}
+	})
+}
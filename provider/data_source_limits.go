@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func dataSourceLimits() *schema.Resource {
+	return &schema.Resource{
+		Description: `Fetch the plan limits of the account, or of the organization.
+The Neon API reports only the plan of the organization, hence its numeric limits are zero.
+The history retention and the allowed IPs support of the plan are not provided
+because the Neon API does not report them.
+See details: https://neon.com/docs/introduction/plans`,
+		SchemaVersion: 1,
+		ReadContext:   dataSourceLimitsRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `The organisation ID to fetch the limits of.
+The limits of the account which owns the API key are fetched if not set.`,
+			},
+			"plan": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Plan name.",
+			},
+			"max_autoscaling_limit_cu": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Max number of Compute Units per endpoint. Zero if unknown.",
+			},
+			"branches_limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Max number of branches per project. Zero if unknown.",
+			},
+			"projects_limit": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Max number of projects. Zero if unknown.",
+			},
+		},
+	}
+}
+
+func dataSourceLimitsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read Limits")
+
+	orgID := d.Get("org_id").(string)
+	limits, err := fetchLimits(meta.(sdkLimits), orgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if orgID != "" {
+		d.SetId(orgID + "/limits")
+	} else {
+		d.SetId("limits")
+	}
+
+	if err := d.Set("plan", limits.Plan); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("max_autoscaling_limit_cu", limits.MaxAutoscalingLimitCU); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("branches_limit", limits.BranchesLimit); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("projects_limit", limits.ProjectsLimit); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
+
+type sdkLimits interface {
+	GetCurrentUserInfo() (neon.CurrentUserInfoResponse, error)
+	GetOrganization(orgID string) (neon.Organization, error)
+}
+
+// limits defines the plan limits reported by the API. Zero value of the numeric limit means that it's unknown.
+type limits struct {
+	Plan                  string
+	MaxAutoscalingLimitCU float64
+	BranchesLimit         int
+	ProjectsLimit         int
+}
+
+// fetchLimits reads the limits of the organization orgID, or of the account if orgID is empty.
+func fetchLimits(client sdkLimits, orgID string) (limits, error) {
+	if orgID != "" {
+		resp, err := client.GetOrganization(orgID)
+		if err != nil {
+			return limits{}, err
+		}
+		return limits{Plan: resp.Plan}, nil
+	}
+
+	resp, err := client.GetCurrentUserInfo()
+	if err != nil {
+		return limits{}, err
+	}
+
+	plan := resp.Plan
+	if resp.BillingAccount != nil && resp.BillingAccount.SubscriptionType != "" &&
+		resp.BillingAccount.SubscriptionType != neon.BillingSubscriptionTypeUNKNOWN {
+		plan = string(resp.BillingAccount.SubscriptionType)
+	}
+
+	return limits{
+		Plan:                  plan,
+		MaxAutoscalingLimitCU: float64(resp.MaxAutoscalingLimit),
+		BranchesLimit:         int(resp.BranchesLimit),
+		ProjectsLimit:         int(resp.ProjectsLimit),
+	}, nil
+}
+
+func checkAutoscalingLimits(prefix string, minCU, maxCU float64, l limits) error {
+	if minCU > 0 && maxCU > 0 && minCU > maxCU {
+		return fmt.Errorf("%sautoscaling_limit_min_cu %v exceeds %sautoscaling_limit_max_cu %v",
+			prefix, minCU, prefix, maxCU)
+	}
+	if l.MaxAutoscalingLimitCU > 0 && maxCU > l.MaxAutoscalingLimitCU {
+		return fmt.Errorf("%sautoscaling_limit_max_cu %v exceeds the limit of the plan %s: %v",
+			prefix, maxCU, l.Plan, l.MaxAutoscalingLimitCU)
+	}
+	return nil
+}
+
+// resourceProjectCustomizeDiff rejects the project's settings which are not supported by the region and the plan.
+// Only the limits reported by the API are verified, and they are not verified if they cannot be fetched.
+func resourceProjectCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.HasChange("region_id") && d.NewValueKnown("region_id") {
+		if v := d.Get("region_id").(string); v != "" {
+			if err := checkRegion(ctx, meta.(sdkRegions), v); err != nil {
+				return err
+			}
+		}
+	}
+
+	const (
+		keyMinCU = "default_endpoint_settings.0.autoscaling_limit_min_cu"
+		keyMaxCU = "default_endpoint_settings.0.autoscaling_limit_max_cu"
+	)
+
+	if !(d.HasChange(keyMinCU) || d.HasChange(keyMaxCU)) ||
+		!d.NewValueKnown(keyMinCU) || !d.NewValueKnown(keyMaxCU) || !d.NewValueKnown("org_id") {
+		return nil
+	}
+
+	l, err := fetchLimits(meta.(sdkLimits), d.Get("org_id").(string))
+	if err != nil {
+		tflog.Warn(ctx, "plan limits not verified", map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+
+	minCU, _ := d.Get(keyMinCU).(float64)
+	maxCU, _ := d.Get(keyMaxCU).(float64)
+	return checkAutoscalingLimits("default_endpoint_settings.0.", minCU, maxCU, l)
+}
+
+// resourceEndpointCustomizeDiff rejects the endpoint's autoscaling limits which are not supported by the plan.
+// The plan limits are not verified if they cannot be fetched.
+func resourceEndpointCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	const (
+		keyMinCU = "autoscaling_limit_min_cu"
+		keyMaxCU = "autoscaling_limit_max_cu"
+	)
+
+	if !(d.HasChange(keyMinCU) || d.HasChange(keyMaxCU)) ||
+		!d.NewValueKnown(keyMinCU) || !d.NewValueKnown(keyMaxCU) || !d.NewValueKnown("project_id") {
+		return nil
+	}
+
+	minCU := d.Get(keyMinCU).(float64)
+	maxCU := d.Get(keyMaxCU).(float64)
+	if err := checkAutoscalingLimits("", minCU, maxCU, limits{}); err != nil {
+		return err
+	}
+
+	resp, err := meta.(sdkProjectReader).GetProject(d.Get("project_id").(string))
+	if err != nil {
+		tflog.Warn(ctx, "plan limits not verified", map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+
+	var orgID string
+	if resp.Project.OrgID != nil {
+		orgID = *resp.Project.OrgID
+	}
+
+	l, err := fetchLimits(meta.(sdkLimits), orgID)
+	if err != nil {
+		tflog.Warn(ctx, "plan limits not verified", map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+
+	return checkAutoscalingLimits("", minCU, maxCU, l)
+}
@@ -0,0 +1,124 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_fetchLimits(t *testing.T) {
+	t.Parallel()
+
+	client := &sdkClientStub{
+		stubLimits: stubLimits{
+			CurrentUserInfo: neon.CurrentUserInfoResponse{
+				Plan:                "launch",
+				MaxAutoscalingLimit: 4,
+				BranchesLimit:       10,
+				ProjectsLimit:       100,
+				BillingAccount:      &neon.BillingAccount{SubscriptionType: neon.BillingSubscriptionTypeScaleV3},
+			},
+		},
+		stubOrganization: stubOrganization{
+			Organization: neon.Organization{ID: "org-foo", Plan: "enterprise"},
+		},
+	}
+
+	tests := []struct {
+		name  string
+		orgID string
+		want  limits
+	}{
+		{
+			name: "account limits",
+			want: limits{
+				Plan:                  "scale_v3",
+				MaxAutoscalingLimitCU: 4,
+				BranchesLimit:         10,
+				ProjectsLimit:         100,
+			},
+		},
+		{
+			name:  "organization limits",
+			orgID: "org-foo",
+			want: limits{
+				Plan: "enterprise",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fetchLimits(client, tt.orgID)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("fetchLimits() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_checkAutoscalingLimits(t *testing.T) {
+	l := limits{Plan: "launch_v3", MaxAutoscalingLimitCU: 16}
+
+	tests := []struct {
+		name    string
+		minCU   float64
+		maxCU   float64
+		wantErr bool
+	}{
+		{
+			name:  "within the limit",
+			minCU: 0.25,
+			maxCU: 16,
+		},
+		{
+			name:    "max exceeds the limit",
+			minCU:   0.25,
+			maxCU:   32,
+			wantErr: true,
+		},
+		{
+			name:    "min exceeds max",
+			minCU:   4,
+			maxCU:   2,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkAutoscalingLimits("", tt.minCU, tt.maxCU, l); (err != nil) != tt.wantErr {
+				t.Errorf("checkAutoscalingLimits() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_checkRegion(t *testing.T) {
+	client := &sdkClientStub{
+		stubLimits: stubLimits{
+			Regions: []neon.RegionResponse{{RegionID: "aws-us-east-2"}, {RegionID: "aws-eu-central-1"}},
+		},
+	}
+
+	if err := checkRegion(context.TODO(), client, "aws-eu-central-1"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := checkRegion(context.TODO(), client, "aws-eu-centrall-1"); err == nil {
+		t.Error("error expected")
+	}
+
+	t.Run("shall skip the verification if the regions cannot be fetched", func(t *testing.T) {
+		client := &sdkClientStub{stubLimits: stubLimits{err: errors.New("foo")}}
+		if err := checkRegion(context.TODO(), client, "aws-eu-central-1"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,215 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func dataSourceOperations() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Fetch Project Operations. The operations are sorted by the creation time, the newest first.",
+		SchemaVersion: 1,
+		ReadContext:   dataSourceOperationsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project ID.",
+			},
+			"branch_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter the operations by the branch ID.",
+			},
+			"endpoint_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter the operations by the endpoint ID.",
+			},
+			"action": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter the operations by the action, e.g. apply_config, start_compute, create_branch.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter the operations by the status, e.g. running, finished, failed.",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum number of operations to return. All operations are returned if not set.",
+				ValidateFunc: intValidationNotNegative,
+			},
+			"operations": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Operation ID.",
+						},
+						"action": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Action performed by the operation.",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Operation status.",
+						},
+						"branch_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Branch ID.",
+						},
+						"endpoint_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Endpoint ID.",
+						},
+						"error": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Error occurred while performing the operation.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Operation creation timestamp.",
+						},
+						"updated_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp of the last operation status update.",
+						},
+						"total_duration_ms": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total duration of the operation in milliseconds.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOperationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read Operations")
+
+	projectID := d.Get("project_id").(string)
+
+	d.SetId(projectID + "/operations")
+
+	filter := operationsFilter{
+		BranchID:   d.Get("branch_id").(string),
+		EndpointID: d.Get("endpoint_id").(string),
+		Action:     neon.OperationAction(d.Get("action").(string)),
+		Status:     neon.OperationStatus(d.Get("status").(string)),
+	}
+
+	resp, err := listProjectOperations(meta.(sdkOperations), projectID, filter, d.Get("limit").(int))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var operations = make([]map[string]interface{}, len(resp))
+	for i, v := range resp {
+		var branchID, endpointID, errMsg string
+		if v.BranchID != nil {
+			branchID = *v.BranchID
+		}
+		if v.EndpointID != nil {
+			endpointID = *v.EndpointID
+		}
+		if v.Error != nil {
+			errMsg = *v.Error
+		}
+
+		operations[i] = map[string]interface{}{
+			"id":                v.ID,
+			"action":            string(v.Action),
+			"status":            string(v.Status),
+			"branch_id":         branchID,
+			"endpoint_id":       endpointID,
+			"error":             errMsg,
+			"created_at":        v.CreatedAt.Format(time.RFC3339),
+			"updated_at":        v.UpdatedAt.Format(time.RFC3339),
+			"total_duration_ms": int(v.TotalDurationMs),
+		}
+	}
+
+	if err := d.Set("operations", operations); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
+
+type sdkOperations interface {
+	ListProjectOperations(projectID string, cursor *string, limit *int) (neon.ListOperations, error)
+}
+
+// operationsFilter defines the criteria to filter the operations. Zero values are ignored.
+type operationsFilter struct {
+	BranchID, EndpointID string
+	Action               neon.OperationAction
+	Status               neon.OperationStatus
+}
+
+func (f operationsFilter) match(op neon.Operation) bool {
+	switch {
+	case f.BranchID != "" && (op.BranchID == nil || *op.BranchID != f.BranchID),
+		f.EndpointID != "" && (op.EndpointID == nil || *op.EndpointID != f.EndpointID),
+		f.Action != "" && op.Action != f.Action,
+		f.Status != "" && op.Status != f.Status:
+		return false
+	}
+	return true
+}
+
+// operationsPageLimit defines the max number of operations fetched per page.
+const operationsPageLimit = 1000
+
+// listProjectOperations reads the operations matching the filter page by page following the pagination cursor.
+// All matching operations are returned if limit is zero.
+func listProjectOperations(c sdkOperations, projectID string, filter operationsFilter, limit int) (
+	[]neon.Operation, error,
+) {
+	var (
+		o      []neon.Operation
+		cursor *string
+	)
+	for {
+		resp, err := c.ListProjectOperations(projectID, cursor, pointer(operationsPageLimit))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, op := range resp.Operations {
+			if !filter.match(op) {
+				continue
+			}
+			o = append(o, op)
+			if limit > 0 && len(o) == limit {
+				return o, nil
+			}
+		}
+
+		if len(resp.Operations) < operationsPageLimit || resp.Pagination == nil || resp.Pagination.Cursor == "" ||
+			(cursor != nil && *cursor == resp.Pagination.Cursor) {
+			return o, nil
+		}
+		cursor = pointer(resp.Pagination.Cursor)
+	}
+}
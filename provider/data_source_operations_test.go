@@ -0,0 +1,103 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_listProjectOperations(t *testing.T) {
+	t.Parallel()
+
+	// every third operation is performed on the branch br-foo, the rest on the branch br-bar
+	var ops = make([]neon.Operation, 2*operationsPageLimit+10)
+	for i := range ops {
+		branchID := "br-bar"
+		if i%3 == 0 {
+			branchID = "br-foo"
+		}
+		ops[i] = neon.Operation{
+			ID:       fmt.Sprintf("op-%d", i),
+			BranchID: pointer(branchID),
+			Action:   neon.OperationActionStartCompute,
+			Status:   neon.OperationStatusFinished,
+		}
+	}
+	client := &sdkClientStub{stubOperations: stubOperations{Operations: ops}}
+
+	tests := []struct {
+		name   string
+		filter operationsFilter
+		limit  int
+		want   int
+	}{
+		{
+			name: "all operations",
+			want: len(ops),
+		},
+		{
+			name:   "filtered by branch",
+			filter: operationsFilter{BranchID: "br-foo"},
+			want:   (len(ops) + 2) / 3,
+		},
+		{
+			name:   "filtered by branch with limit",
+			filter: operationsFilter{BranchID: "br-foo"},
+			limit:  5,
+			want:   5,
+		},
+		{
+			name:   "filtered by status",
+			filter: operationsFilter{Status: neon.OperationStatusRunning},
+			want:   0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := listProjectOperations(client, "foo", tt.filter, tt.limit)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != tt.want {
+				t.Errorf("unexpected number of operations: want=%d, got=%d", tt.want, len(got))
+			}
+			for _, op := range got {
+				if !tt.filter.match(op) {
+					t.Fatalf("operation %s does not match the filter", op.ID)
+				}
+			}
+		})
+	}
+}
+
+func Test_waitProjectOperations(t *testing.T) {
+	t.Parallel()
+
+	client := &sdkClientStub{
+		stubOperations: stubOperations{
+			Operations: []neon.Operation{
+				{ID: "op-0", BranchID: pointer("br-foo"), Status: neon.OperationStatusRunning},
+				{ID: "op-1", BranchID: pointer("br-bar"), Status: neon.OperationStatusFinished},
+			},
+		},
+	}
+
+	t.Run("shall not wait for the finished operations of the branch", func(t *testing.T) {
+		if err := waitProjectOperations(context.TODO(), client, "foo", "br-bar"); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("shall fail when the timeout is reached", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.TODO())
+		cancel()
+		if err := waitProjectOperations(ctx, client, "foo", ""); err == nil {
+			t.Error("error expected")
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceOrganization() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Fetch Organization and its members.",
+		SchemaVersion: 1,
+		ReadContext:   dataSourceOrganizationRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The organisation ID.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Organization name.",
+			},
+			"handle": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Organization handle.",
+			},
+			"plan": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Organization's billing plan.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Organization creation timestamp.",
+			},
+			"members": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Member ID.",
+						},
+						"user_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "User ID.",
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Email of the member.",
+						},
+						"role": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Member's role in the organization.",
+						},
+						"joined_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Timestamp when the user joined the organization.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceOrganizationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read Organization")
+
+	orgID := d.Get("org_id").(string)
+	client := meta.(sdkOrganization)
+
+	org, err := client.GetOrganization(orgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := client.GetOrganizationMembers(orgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(org.ID)
+
+	if err := d.Set("name", org.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("handle", org.Handle); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("plan", org.Plan); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created_at", org.CreatedAt.Format(time.RFC3339)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var members = make([]map[string]interface{}, len(resp.Members))
+	for i, v := range resp.Members {
+		var joinedAt string
+		if v.Member.JoinedAt != nil {
+			joinedAt = v.Member.JoinedAt.Format(time.RFC3339)
+		}
+		members[i] = map[string]interface{}{
+			"id":        v.Member.ID,
+			"user_id":   v.Member.UserID,
+			"email":     v.User.Email,
+			"role":      string(v.Member.Role),
+			"joined_at": joinedAt,
+		}
+	}
+
+	if err := d.Set("members", members); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
@@ -0,0 +1,54 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceOrganizationConsumption() *schema.Resource {
+	return &schema.Resource{
+		Description: `Fetch Organization Consumption history.
+The consumption history is available for Scale, Business, and Enterprise plan organizations.
+The Neon API does not report the data transfer per timeframe, hence it is not provided.
+See details: https://neon.com/docs/guides/consumption-metrics`,
+		SchemaVersion: 1,
+		ReadContext:   dataSourceOrganizationConsumptionRead,
+		Schema: newSchemaConsumption(map[string]*schema.Schema{
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The organisation ID.",
+			},
+		}),
+	}
+}
+
+func dataSourceOrganizationConsumptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read Organization Consumption")
+
+	orgID := d.Get("org_id").(string)
+
+	q, err := newConsumptionQuery(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := meta.(sdkConsumption).GetConsumptionHistoryPerAccount(
+		q.from, q.to, q.granularity, pointer(orgID), nil, nil,
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/consumption/%s", orgID, q))
+
+	if err := updateStateConsumption(d, resp.Periods); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
@@ -0,0 +1,263 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func dataSourceProjectConsumption() *schema.Resource {
+	return &schema.Resource{
+		Description: `Fetch Project Consumption history.
+The consumption history is available for Scale, Business, and Enterprise plan projects.
+The Neon API does not report the data transfer per timeframe, hence only the data transfer
+over the current billing period is provided.
+See details: https://neon.com/docs/guides/consumption-metrics`,
+		SchemaVersion: 1,
+		ReadContext:   dataSourceProjectConsumptionRead,
+		Schema: newSchemaConsumption(map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project ID.",
+			},
+			"data_transfer_bytes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Egress data transfer in bytes over the current billing period.",
+			},
+		}),
+	}
+}
+
+func dataSourceProjectConsumptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read Project Consumption")
+
+	projectID := d.Get("project_id").(string)
+
+	q, err := newConsumptionQuery(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := meta.(sdkConsumption).GetConsumptionHistoryPerProject(
+		nil, nil, []string{projectID}, q.from, q.to, q.granularity, nil, nil, nil,
+	)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var periods []neon.ConsumptionHistoryPerPeriod
+	for _, v := range resp.Projects {
+		if v.ProjectID == projectID {
+			periods = v.Periods
+			break
+		}
+	}
+
+	project, err := meta.(sdkConsumption).GetProject(projectID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/consumption/%s", projectID, q))
+
+	if err := updateStateConsumption(d, periods); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("data_transfer_bytes", project.Project.DataTransferBytes); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
+
+// newSchemaConsumption defines the schema of the consumption history data source extended with the attributes s.
+func newSchemaConsumption(s map[string]*schema.Schema) map[string]*schema.Schema {
+	validateTimestamp := func(i interface{}, k string) (warns []string, errs []error) {
+		if _, err := time.Parse(time.RFC3339, i.(string)); err != nil {
+			errs = append(errs, fmt.Errorf("%s must be a timestamp in the RFC3339 format: %w", k, err))
+		}
+		return
+	}
+
+	o := map[string]*schema.Schema{
+		"from": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "Start of the consumption history time range in the RFC3339 format, e.g. 2025-06-01T00:00:00Z.",
+			ValidateFunc: validateTimestamp,
+		},
+		"to": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "End of the consumption history time range in the RFC3339 format, e.g. 2025-07-01T00:00:00Z.",
+			ValidateFunc: validateTimestamp,
+		},
+		"granularity": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: `Granularity of the consumption history. Allowed values: "hourly", "daily", "monthly".`,
+			ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+				switch v := neon.ConsumptionHistoryGranularity(i.(string)); v {
+				case neon.ConsumptionHistoryGranularityHourly, neon.ConsumptionHistoryGranularityDaily,
+					neon.ConsumptionHistoryGranularityMonthly:
+				default:
+					errs = append(errs, errors.New(string(v)+" is not supported value for "+s))
+				}
+				return
+			},
+		},
+		"consumption": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "Consumption per timeframe of the requested granularity.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"period_id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "ID of the billing period.",
+					},
+					"timeframe_start": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Start of the timeframe.",
+					},
+					"timeframe_end": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "End of the timeframe.",
+					},
+					"active_time_seconds": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Time in seconds the compute endpoints have been active.",
+					},
+					"compute_time_seconds": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "CPU seconds used by the compute endpoints.",
+					},
+					"written_data_bytes": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Amount of written data in bytes.",
+					},
+					"synthetic_storage_size_bytes": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Space occupied in storage in bytes.",
+					},
+				},
+			},
+		},
+		"total_active_time_seconds": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Total time in seconds the compute endpoints have been active over the time range.",
+		},
+		"total_compute_time_seconds": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Total CPU seconds used by the compute endpoints over the time range.",
+		},
+		"total_written_data_bytes": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Total amount of written data in bytes over the time range.",
+		},
+		"max_synthetic_storage_size_bytes": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Max space occupied in storage in bytes over the time range.",
+		},
+	}
+
+	for k, v := range s {
+		o[k] = v
+	}
+	return o
+}
+
+type consumptionQuery struct {
+	from, to    time.Time
+	granularity neon.ConsumptionHistoryGranularity
+}
+
+func (q consumptionQuery) String() string {
+	return fmt.Sprintf("%s/%s/%s", q.from.Format(time.RFC3339), q.to.Format(time.RFC3339), q.granularity)
+}
+
+func newConsumptionQuery(d *schema.ResourceData) (consumptionQuery, error) {
+	from, err := time.Parse(time.RFC3339, d.Get("from").(string))
+	if err != nil {
+		return consumptionQuery{}, err
+	}
+	to, err := time.Parse(time.RFC3339, d.Get("to").(string))
+	if err != nil {
+		return consumptionQuery{}, err
+	}
+	if !from.Before(to) {
+		return consumptionQuery{}, errors.New("from must be before to")
+	}
+	return consumptionQuery{
+		from:        from,
+		to:          to,
+		granularity: neon.ConsumptionHistoryGranularity(d.Get("granularity").(string)),
+	}, nil
+}
+
+func updateStateConsumption(d *schema.ResourceData, periods []neon.ConsumptionHistoryPerPeriod) error {
+	var (
+		consumption                                   []map[string]interface{}
+		activeTime, computeTime, writtenData, storage int
+	)
+	for _, period := range periods {
+		for _, v := range period.Consumption {
+			consumption = append(consumption, map[string]interface{}{
+				"period_id":                    period.PeriodID,
+				"timeframe_start":              v.TimeframeStart.Format(time.RFC3339),
+				"timeframe_end":                v.TimeframeEnd.Format(time.RFC3339),
+				"active_time_seconds":          v.ActiveTimeSeconds,
+				"compute_time_seconds":         v.ComputeTimeSeconds,
+				"written_data_bytes":           v.WrittenDataBytes,
+				"synthetic_storage_size_bytes": v.SyntheticStorageSizeBytes,
+			})
+
+			activeTime += v.ActiveTimeSeconds
+			computeTime += v.ComputeTimeSeconds
+			writtenData += v.WrittenDataBytes
+			storage = max(storage, v.SyntheticStorageSizeBytes)
+		}
+	}
+
+	if err := d.Set("consumption", consumption); err != nil {
+		return err
+	}
+	if err := d.Set("total_active_time_seconds", activeTime); err != nil {
+		return err
+	}
+	if err := d.Set("total_compute_time_seconds", computeTime); err != nil {
+		return err
+	}
+	if err := d.Set("total_written_data_bytes", writtenData); err != nil {
+		return err
+	}
+	return d.Set("max_synthetic_storage_size_bytes", storage)
+}
+
+type sdkConsumption interface {
+	GetConsumptionHistoryPerAccount(from time.Time, to time.Time, granularity neon.ConsumptionHistoryGranularity,
+		orgID *string, includeV1Metrics *bool, metrics []string) (neon.ConsumptionHistoryPerAccountResponse, error)
+	GetConsumptionHistoryPerProject(cursor *string, limit *int, projectIDs []string, from time.Time, to time.Time,
+		granularity neon.ConsumptionHistoryGranularity, orgID *string, includeV1Metrics *bool, metrics []string) (
+		neon.GetConsumptionHistoryPerProjectRespObj, error)
+	GetProject(projectID string) (neon.ProjectResponse, error)
+}
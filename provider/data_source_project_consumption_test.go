@@ -0,0 +1,91 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_dataSourceProjectConsumptionRead(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	start := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	meta := &sdkClientStub{
+		stubConsumption: stubConsumption{
+			Periods: []neon.ConsumptionHistoryPerPeriod{
+				{
+					PeriodID: "foo",
+					Consumption: []neon.ConsumptionHistoryPerTimeframe{
+						{
+							TimeframeStart:            start,
+							TimeframeEnd:              start.Add(24 * time.Hour),
+							ActiveTimeSeconds:         100,
+							ComputeTimeSeconds:        25,
+							WrittenDataBytes:          1000,
+							SyntheticStorageSizeBytes: 2000,
+						},
+						{
+							TimeframeStart:            start.Add(24 * time.Hour),
+							TimeframeEnd:              start.Add(48 * time.Hour),
+							ActiveTimeSeconds:         200,
+							ComputeTimeSeconds:        50,
+							WrittenDataBytes:          3000,
+							SyntheticStorageSizeBytes: 1500,
+						},
+					},
+				},
+			},
+		},
+		project: neon.Project{ID: "myproject", DataTransferBytes: 5000},
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		d := dataSourceProjectConsumption().TestResourceData()
+		_ = d.Set("project_id", "myproject")
+		_ = d.Set("from", "2025-06-01T00:00:00Z")
+		_ = d.Set("to", "2025-06-03T00:00:00Z")
+		_ = d.Set("granularity", "daily")
+
+		if diags := dataSourceProjectConsumptionRead(context.TODO(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags[0].Summary)
+		}
+
+		if n := d.Get("consumption.#").(int); n != 2 {
+			t.Errorf("unexpected number of timeframes: %d", n)
+		}
+
+		for k, want := range map[string]int{
+			"total_active_time_seconds":        300,
+			"total_compute_time_seconds":       75,
+			"total_written_data_bytes":         4000,
+			"max_synthetic_storage_size_bytes": 2000,
+			"data_transfer_bytes":              5000,
+		} {
+			if got := d.Get(k).(int); got != want {
+				t.Errorf("unexpected %s: want=%d, got=%d", k, want, got)
+			}
+		}
+	})
+
+	t.Run("unhappy path: invalid time range", func(t *testing.T) {
+		d := dataSourceProjectConsumption().TestResourceData()
+		_ = d.Set("project_id", "myproject")
+		_ = d.Set("from", "2025-06-03T00:00:00Z")
+		_ = d.Set("to", "2025-06-01T00:00:00Z")
+		_ = d.Set("granularity", "daily")
+
+		if diags := dataSourceProjectConsumptionRead(context.TODO(), d, meta); !diags.HasError() {
+			t.Fatal("error expected")
+		}
+	})
+}
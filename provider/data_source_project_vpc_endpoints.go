@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func dataSourceProjectVPCEndpoints() *schema.Resource {
+	return &schema.Resource{
+		Description: `Fetch the VPC endpoint restrictions of the project.
+See details: https://neon.tech/docs/guides/neon-private-networking`,
+		SchemaVersion: 1,
+		ReadContext:   dataSourceProjectVPCEndpointsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Neon project ID.",
+			},
+			"vpc_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_endpoint_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The VPC endpoint ID.",
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A descriptive label for the VPC endpoint.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProjectVPCEndpointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read Project VPC Endpoints")
+
+	projectID := d.Get("project_id").(string)
+
+	d.SetId(projectID + "/vpc_endpoints")
+
+	resp, err := meta.(sdkProjectVPCEndpoints).ListProjectVPCEndpoints(projectID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var endpoints = make([]map[string]interface{}, len(resp.Endpoints))
+	for i, v := range resp.Endpoints {
+		endpoints[i] = map[string]interface{}{
+			"vpc_endpoint_id": v.VpcEndpointID,
+			"label":           v.Label,
+		}
+	}
+
+	if err := d.Set("vpc_endpoints", endpoints); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
+
+type sdkProjectVPCEndpoints interface {
+	ListProjectVPCEndpoints(projectID string) (neon.VPCEndpointsResponse, error)
+}
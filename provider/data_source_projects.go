@@ -0,0 +1,185 @@
+package provider
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func dataSourceProjects() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Fetch Projects.",
+		SchemaVersion: 1,
+		ReadContext:   dataSourceProjectsRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `The organisation ID to list the projects of.
+The projects of the personal account are listed if not set.`,
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Search query by the project name or ID. Partial match is supported.",
+			},
+			"region_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter the projects by the region ID.",
+			},
+			"pg_version": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "Filter the projects by the Postgres version.",
+			},
+			"projects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Project ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Project Name.",
+						},
+						"region_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Project's region ID.",
+						},
+						"pg_version": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Postgres version.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Project creation timestamp.",
+						},
+						"default_branch_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Default branch ID.",
+						},
+						"org_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The organisation ID the project belongs to.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceProjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read Projects")
+
+	var orgID, search *string
+	if v, ok := d.GetOk("org_id"); ok {
+		orgID = pointer(v.(string))
+	}
+	if v, ok := d.GetOk("search"); ok {
+		search = pointer(v.(string))
+	}
+
+	client := meta.(sdkProjects)
+	resp, err := listProjects(client, orgID, search)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	regionID := d.Get("region_id").(string)
+	pgVersion := d.Get("pg_version").(int)
+
+	var projects = make([]map[string]interface{}, 0, len(resp))
+	for _, v := range resp {
+		if regionID != "" && v.RegionID != regionID {
+			continue
+		}
+		if pgVersion > 0 && int(v.PgVersion) != pgVersion {
+			continue
+		}
+
+		branches, err := listProjectBranches(client, v.ID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		var defaultBranchID string
+		for _, branch := range branches {
+			if branch.Default {
+				defaultBranchID = branch.ID
+				break
+			}
+		}
+
+		var projectOrgID string
+		if v.OrgID != nil {
+			projectOrgID = *v.OrgID
+		}
+
+		projects = append(projects, map[string]interface{}{
+			"id":                v.ID,
+			"name":              v.Name,
+			"region_id":         v.RegionID,
+			"pg_version":        int(v.PgVersion),
+			"created_at":        v.CreatedAt.Format(time.RFC3339),
+			"default_branch_id": defaultBranchID,
+			"org_id":            projectOrgID,
+		})
+	}
+
+	var id = "projects"
+	if orgID != nil {
+		id = *orgID + "/projects"
+	}
+	d.SetId(id)
+
+	if err := d.Set("projects", projects); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
+
+type sdkProjects interface {
+	ListProjects(cursor *string, limit *int, search *string, orgID *string, timeout *int) (neon.ListProjectsRespObj,
+		error)
+	sdkBranches
+}
+
+// projectsPageLimit defines the max number of projects fetched per page.
+const projectsPageLimit = 400
+
+// listProjects reads all projects page by page following the pagination cursor.
+func listProjects(c sdkProjects, orgID, search *string) ([]neon.ProjectListItem, error) {
+	var (
+		o      []neon.ProjectListItem
+		cursor *string
+	)
+	for {
+		resp, err := c.ListProjects(cursor, pointer(projectsPageLimit), search, orgID, nil)
+		if err != nil {
+			return nil, err
+		}
+		o = append(o, resp.Projects...)
+
+		if len(resp.Projects) < projectsPageLimit || resp.Pagination == nil || resp.Pagination.Cursor == "" ||
+			(cursor != nil && *cursor == resp.Pagination.Cursor) {
+			return o, nil
+		}
+		cursor = pointer(resp.Pagination.Cursor)
+	}
+}
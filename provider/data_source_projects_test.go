@@ -0,0 +1,67 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_listProjects(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		n            int
+		wantRequests int
+	}{
+		{
+			name:         "no projects",
+			n:            0,
+			wantRequests: 1,
+		},
+		{
+			name:         "single page",
+			n:            projectsPageLimit - 1,
+			wantRequests: 1,
+		},
+		{
+			name:         "several pages",
+			n:            2*projectsPageLimit + 1,
+			wantRequests: 3,
+		},
+		{
+			name:         "last page is empty",
+			n:            projectsPageLimit,
+			wantRequests: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var projects = make([]neon.ProjectListItem, tt.n)
+			for i := range projects {
+				projects[i] = neon.ProjectListItem{ID: fmt.Sprintf("project-%d", i)}
+			}
+			client := &sdkClientStub{stubProjects: stubProjects{Projects: projects}}
+
+			got, err := listProjects(client, nil, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(got) != tt.n {
+				t.Errorf("unexpected number of projects: want=%d, got=%d", tt.n, len(got))
+			}
+			for i, v := range got {
+				if v.ID != projects[i].ID {
+					t.Fatalf("unexpected project at position %d: want=%s, got=%s", i, projects[i].ID, v.ID)
+				}
+			}
+			if client.requests != tt.wantRequests {
+				t.Errorf("unexpected number of requests: want=%d, got=%d", tt.wantRequests, client.requests)
+			}
+		})
+	}
+}
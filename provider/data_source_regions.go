@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func dataSourceRegions() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Fetch active Regions. See details: https://neon.tech/docs/introduction/regions",
+		SchemaVersion: 1,
+		ReadContext:   dataSourceRegionsRead,
+		Schema: map[string]*schema.Schema{
+			"regions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Region ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Short description of the region.",
+						},
+						"default": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Flag of the region used by default for new projects.",
+						},
+						"geo_lat": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Approximate geographical latitude of the region. Empty if unknown.",
+						},
+						"geo_long": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Approximate geographical longitude of the region. Empty if unknown.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRegionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read Regions")
+
+	resp, err := meta.(sdkRegions).GetActiveRegions()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("regions")
+
+	var regions = make([]map[string]interface{}, len(resp.Regions))
+	for i, v := range resp.Regions {
+		regions[i] = map[string]interface{}{
+			"id":       v.RegionID,
+			"name":     v.Name,
+			"default":  v.Default,
+			"geo_lat":  v.GeoLat,
+			"geo_long": v.GeoLong,
+		}
+	}
+
+	if err := d.Set("regions", regions); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
+
+type sdkRegions interface {
+	GetActiveRegions() (neon.ActiveRegionsResponse, error)
+}
+
+// checkRegion verifies that the region is active. The region is not verified if the active regions cannot be fetched.
+func checkRegion(ctx context.Context, client sdkRegions, regionID string) error {
+	resp, err := client.GetActiveRegions()
+	if err != nil {
+		tflog.Warn(ctx, "region not verified", map[string]interface{}{"error": err.Error()})
+		return nil
+	}
+	if len(resp.Regions) == 0 {
+		return nil
+	}
+
+	var regions = make([]string, len(resp.Regions))
+	for i, v := range resp.Regions {
+		regions[i] = v.RegionID
+	}
+
+	if !slices.Contains(regions, regionID) {
+		return fmt.Errorf("region_id %s is not supported, the active regions: %v", regionID, regions)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Fetch Project Snapshots.",
+		SchemaVersion: 1,
+		ReadContext:   dataSourceSnapshotsRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project ID.",
+			},
+			"snapshots": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Snapshot ID.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Snapshot name.",
+						},
+						"branch_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the branch the snapshot was taken of.",
+						},
+						"lsn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Log Sequence Number (LSN) of the snapshot.",
+						},
+						"timestamp": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Point in time of the snapshot as Unix epoch.",
+						},
+						"expires_at": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Expiration timestamp of the snapshot as Unix epoch. Zero if it never expires.",
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Snapshot creation timestamp.",
+						},
+						"manual": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Flag of the snapshot taken on demand, not by schedule.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSnapshotsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read Snapshots")
+
+	projectID := d.Get("project_id").(string)
+
+	d.SetId(fmt.Sprintf("%s/snapshots", projectID))
+
+	resp, err := meta.(sdkSnapshot).ListSnapshots(projectID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var snapshots []map[string]interface{}
+	for _, v := range resp.Snapshots {
+		var branchID, lsn string
+		if v.SourceBranchID != nil {
+			branchID = *v.SourceBranchID
+		}
+		if v.Lsn != nil {
+			lsn = *v.Lsn
+		}
+
+		var timestamp, expiresAt int
+		if v.Timestamp != nil {
+			if timestamp, err = parseSnapshotTimestamp(*v.Timestamp); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+		if v.ExpiresAt != nil {
+			if expiresAt, err = parseSnapshotTimestamp(*v.ExpiresAt); err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		var manual bool
+		if v.Manual != nil {
+			manual = *v.Manual
+		}
+
+		snapshots = append(snapshots, map[string]interface{}{
+			"id":         v.ID,
+			"name":       v.Name,
+			"branch_id":  branchID,
+			"lsn":        lsn,
+			"timestamp":  timestamp,
+			"expires_at": expiresAt,
+			"created_at": v.CreatedAt,
+			"manual":     manual,
+		})
+	}
+
+	if err := d.Set("snapshots", snapshots); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
+)
+
+const defaultSQLQueryStatementTimeoutSeconds = 30
+
+func dataSourceSQLQuery() *schema.Resource {
+	return &schema.Resource{
+		Description: `Fetch the result of the read-only SQL query executed in the database of the branch.
+
+The query must be a single statement which is executed in the read-only transaction.
+The values are returned as strings, NULL is returned as empty string.
+The data source connects to the database via the branch's read-write endpoint, hence the endpoint must exist.`,
+		SchemaVersion: 1,
+		ReadContext:   dataSourceSQLQueryRead,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Project ID.",
+			},
+			"branch_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Branch ID.",
+			},
+			"database": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Database name.",
+			},
+			"role_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Description: `Name of the role to connect to the database as. The database owner is used if not set.
+The role's password is read from the Neon API.`,
+			},
+			"pooled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Connect to the database via the connection pooler.",
+			},
+			"query": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "SQL query, e.g. SELECT name, setting FROM pg_settings WHERE name = $1",
+			},
+			"args": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Values of the query's positional parameters $1, $2, etc.",
+			},
+			"statement_timeout_seconds": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     defaultSQLQueryStatementTimeoutSeconds,
+				Description: "Maximum duration of the query in seconds.",
+				ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+					if v := i.(int); v < 1 {
+						errs = append(errs, errors.New(s+" must be positive, got: "+strconv.Itoa(v)))
+					}
+					return
+				},
+			},
+			"rows": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Query result, every row is the map of the column name to the value.",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+// runReadOnlySQLQuery executes the query in the read-only transaction and returns the rows' values as text.
+func runReadOnlySQLQuery(ctx context.Context, conn *pgx.Conn, query string, args []interface{}, timeoutSeconds int) (
+	[]map[string]string, error,
+) {
+	var o = []map[string]string{}
+	err := pgx.BeginTxFunc(ctx, conn, pgx.TxOptions{AccessMode: pgx.ReadOnly}, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx,
+			"SET LOCAL statement_timeout = "+strconv.Itoa(timeoutSeconds*1000),
+		); err != nil {
+			return err
+		}
+
+		// the extended protocol rejects multiple statements, hence the query cannot end the read-only transaction,
+		// the values are read in the text format
+		rows, err := tx.Query(ctx, query, append([]interface{}{pgx.QueryResultFormats{pgx.TextFormatCode}}, args...)...)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+
+		fields := rows.FieldDescriptions()
+		for rows.Next() {
+			var row = make(map[string]string, len(fields))
+			for i, v := range rows.RawValues() {
+				row[fields[i].Name] = string(v)
+			}
+			o = append(o, row)
+		}
+		return rows.Err()
+	})
+	return o, err
+}
+
+// newSQLQueryID defines the data source's ID as the hash of the query, its arguments, and the connection's settings.
+func newSQLQueryID(t sqlTarget, query string, args []string) string {
+	// the JSON encoding delimits the values unambiguously
+	b, _ := json.Marshal([]interface{}{query, args, t.Role, t.Pooled})
+	sum := sha256.Sum256(b)
+	return newSQLObjectID(t, hex.EncodeToString(sum[:8])).toString()
+}
+
+func dataSourceSQLQueryRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read SQL Query")
+
+	t := newSQLTarget(d)
+	t.Pooled = d.Get("pooled").(bool)
+	t.ReadOnly = true
+
+	query := d.Get("query").(string)
+	var (
+		args   []interface{}
+		argsID []string
+	)
+	for _, v := range d.Get("args").([]interface{}) {
+		args = append(args, v.(string))
+		argsID = append(argsID, v.(string))
+	}
+
+	var rows []map[string]string
+	if err := withSQLConn(ctx, meta, t, func(conn *pgx.Conn) error {
+		var err error
+		rows, err = runReadOnlySQLQuery(ctx, conn, query, args, d.Get("statement_timeout_seconds").(int))
+		return err
+	}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newSQLQueryID(t, query, argsID))
+
+	var o = make([]interface{}, len(rows))
+	for i, row := range rows {
+		var v = make(map[string]interface{}, len(row))
+		for k, el := range row {
+			v[k] = el
+		}
+		o[i] = v
+	}
+	if err := d.Set("rows", o); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
@@ -0,0 +1,111 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Test_newSQLQueryID(t *testing.T) {
+	target := sqlTarget{ProjectID: "myproject", BranchID: "br-foo", Database: "neondb"}
+	const query = `SELECT $1::text`
+
+	id := newSQLQueryID(target, query, []string{"foo"})
+	if !strings.HasPrefix(id, "myproject/br-foo/neondb/") {
+		t.Errorf("unexpected ID: %s", id)
+	}
+	if newSQLQueryID(target, query, []string{"foo"}) != id {
+		t.Error("the ID is expected to be deterministic")
+	}
+
+	withRole := target
+	withRole.Role = "foo"
+	pooled := target
+	pooled.Pooled = true
+	for name, got := range map[string]string{
+		"args":  newSQLQueryID(target, query, []string{"bar"}),
+		"query": newSQLQueryID(target, `SELECT $1::int`, []string{"foo"}),
+		"role":  newSQLQueryID(withRole, query, []string{"foo"}),
+		"pool":  newSQLQueryID(pooled, query, []string{"foo"}),
+	} {
+		if got == id {
+			t.Errorf("the ID is expected to differ by %s", name)
+		}
+	}
+}
+
+func Test_dataSourceSQLQueryRead(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	meta := newTestSQLMeta(t)
+
+	newDefinition := func(query string, args ...interface{}) *schema.ResourceData {
+		d := dataSourceSQLQuery().TestResourceData()
+		_ = d.Set("project_id", "myproject")
+		_ = d.Set("branch_id", "br-foo")
+		_ = d.Set("database", "postgres")
+		_ = d.Set("query", query)
+		_ = d.Set("args", args)
+		_ = d.Set("statement_timeout_seconds", 1)
+		return d
+	}
+
+	t.Run("shall return the rows as maps of strings", func(t *testing.T) {
+		d := newDefinition(`SELECT name, setting, NULL AS empty FROM pg_settings WHERE name = $1`, "max_connections")
+		if diags := dataSourceSQLQueryRead(context.TODO(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags[0].Summary)
+		}
+		if d.Id() == "" {
+			t.Error("ID expected to be set")
+		}
+		rows := d.Get("rows").([]interface{})
+		if len(rows) != 1 {
+			t.Fatalf("unexpected number of rows: %d", len(rows))
+		}
+		row := rows[0].(map[string]interface{})
+		if row["name"] != "max_connections" || row["setting"] == "" || row["empty"] != "" {
+			t.Errorf("unexpected row: %v", row)
+		}
+	})
+
+	t.Run("shall return no rows", func(t *testing.T) {
+		d := newDefinition(`SELECT 1 AS foo WHERE false`)
+		if diags := dataSourceSQLQueryRead(context.TODO(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags[0].Summary)
+		}
+		if n := len(d.Get("rows").([]interface{})); n != 0 {
+			t.Errorf("unexpected number of rows: %d", n)
+		}
+	})
+
+	t.Run("unhappy path: the query shall be read-only", func(t *testing.T) {
+		d := newDefinition(`CREATE TABLE sql_query_test (id int)`)
+		if diags := dataSourceSQLQueryRead(context.TODO(), d, meta); !diags.HasError() {
+			t.Error("error expected")
+		}
+	})
+
+	t.Run("unhappy path: the query shall not end the read-only transaction", func(t *testing.T) {
+		t.Cleanup(func() { execTestSQL(t, meta, `DROP TABLE IF EXISTS sql_query_test`) })
+
+		d := newDefinition(`COMMIT; CREATE TABLE sql_query_test (id int); SELECT 1`)
+		if diags := dataSourceSQLQueryRead(context.TODO(), d, meta); !diags.HasError() {
+			t.Error("error expected")
+		}
+	})
+
+	t.Run("unhappy path: statement timeout", func(t *testing.T) {
+		d := newDefinition(`SELECT pg_sleep(2)`)
+		if diags := dataSourceSQLQueryRead(context.TODO(), d, meta); !diags.HasError() {
+			t.Error("error expected")
+		}
+	})
+}
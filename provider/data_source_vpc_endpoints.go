@@ -0,0 +1,109 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func dataSourceVPCEndpoints() *schema.Resource {
+	return &schema.Resource{
+		Description: `Fetch the VPC endpoints assigned to the organization in the region.
+See details: https://neon.tech/docs/guides/neon-private-networking`,
+		SchemaVersion: 1,
+		ReadContext:   dataSourceVPCEndpointsRead,
+		Schema: map[string]*schema.Schema{
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Neon organization ID.",
+			},
+			"region_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Neon region ID.",
+			},
+			"vpc_endpoints": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc_endpoint_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The VPC endpoint ID.",
+						},
+						"label": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A descriptive label for the VPC endpoint.",
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+							Description: `The current state of the VPC endpoint: "new" if pending acceptance, ` +
+								`"accepted" if the VPC connection was accepted by Neon.`,
+						},
+						"num_restricted_projects": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of projects restricted to use the VPC endpoint.",
+						},
+						"example_restricted_projects": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "IDs of up to three projects restricted to use the VPC endpoint.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceVPCEndpointsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	tflog.Trace(ctx, "read VPC Endpoints")
+
+	orgID := d.Get("org_id").(string)
+	regionID := d.Get("region_id").(string)
+
+	d.SetId(orgID + "/" + regionID + "/vpc_endpoints")
+
+	client := meta.(sdkVPCEndpoints)
+	resp, err := client.ListOrganizationVPCEndpoints(orgID, regionID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var endpoints = make([]map[string]interface{}, len(resp.Endpoints))
+	for i, v := range resp.Endpoints {
+		details, err := client.GetOrganizationVPCEndpointDetails(orgID, regionID, v.VpcEndpointID)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		endpoints[i] = map[string]interface{}{
+			"vpc_endpoint_id":             v.VpcEndpointID,
+			"label":                       details.Label,
+			"state":                       details.State,
+			"num_restricted_projects":     details.NumRestrictedProjects,
+			"example_restricted_projects": details.ExampleRestrictedProjects,
+		}
+	}
+
+	if err := d.Set("vpc_endpoints", endpoints); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diag.FromErr(nil)
+}
+
+type sdkVPCEndpoints interface {
+	ListOrganizationVPCEndpoints(orgID string, regionID string) (neon.VPCEndpointsResponse, error)
+	GetOrganizationVPCEndpointDetails(orgID string, regionID string, vpcEndpointID string) (neon.VPCEndpointDetails,
+		error)
+}
@@ -0,0 +1,105 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_dataSourceVPCEndpointsRead(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	t.Run("happy path", func(t *testing.T) {
+		meta := &sdkClientStub{
+			stubVPCEndpoint: stubVPCEndpoint{
+				VPCEndpoints: []neon.VPCEndpoint{{VpcEndpointID: "vpce-foo", Label: "foo"}},
+				VPCEndpointDetails: neon.VPCEndpointDetails{
+					VpcEndpointID:             "vpce-foo",
+					Label:                     "foo",
+					State:                     "accepted",
+					NumRestrictedProjects:     1,
+					ExampleRestrictedProjects: []string{"myproject"},
+				},
+			},
+		}
+
+		d := dataSourceVPCEndpoints().TestResourceData()
+		_ = d.Set("org_id", "org-foo")
+		_ = d.Set("region_id", "aws-eu-central-1")
+
+		if diags := dataSourceVPCEndpointsRead(context.TODO(), d, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags[0].Summary)
+		}
+
+		if want := "org-foo/aws-eu-central-1/vpc_endpoints"; d.Id() != want {
+			t.Errorf("unexpected ID: want=%s, got=%s", want, d.Id())
+		}
+		if v := d.Get("vpc_endpoints.#").(int); v != 1 {
+			t.Fatalf("unexpected number of VPC endpoints: %d", v)
+		}
+		if v := d.Get("vpc_endpoints.0.state").(string); v != "accepted" {
+			t.Errorf("unexpected state: %s", v)
+		}
+		if v := d.Get("vpc_endpoints.0.example_restricted_projects.0").(string); v != "myproject" {
+			t.Errorf("unexpected example_restricted_projects: %s", v)
+		}
+	})
+
+	t.Run("unhappy path", func(t *testing.T) {
+		meta := &sdkClientStub{
+			stubVPCEndpoint: stubVPCEndpoint{err: errors.New("foobar")},
+		}
+
+		d := dataSourceVPCEndpoints().TestResourceData()
+		_ = d.Set("org_id", "org-foo")
+		_ = d.Set("region_id", "aws-eu-central-1")
+
+		if diags := dataSourceVPCEndpointsRead(context.TODO(), d, meta); !diags.HasError() {
+			t.Fatal("error expected")
+		}
+	})
+}
+
+func Test_dataSourceProjectVPCEndpointsRead(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	meta := &sdkClientStub{
+		stubVPCEndpoint: stubVPCEndpoint{
+			VPCEndpoints: []neon.VPCEndpoint{
+				{VpcEndpointID: "vpce-foo", Label: "foo"},
+				{VpcEndpointID: "vpce-bar", Label: "bar"},
+			},
+		},
+	}
+
+	d := dataSourceProjectVPCEndpoints().TestResourceData()
+	_ = d.Set("project_id", "myproject")
+
+	if diags := dataSourceProjectVPCEndpointsRead(context.TODO(), d, meta); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags[0].Summary)
+	}
+
+	if want := "myproject/vpc_endpoints"; d.Id() != want {
+		t.Errorf("unexpected ID: want=%s, got=%s", want, d.Id())
+	}
+	if v := d.Get("vpc_endpoints.#").(int); v != 2 {
+		t.Fatalf("unexpected number of VPC endpoints: %d", v)
+	}
+	if v := d.Get("vpc_endpoints.1.label").(string); v != "bar" {
+		t.Errorf("unexpected label: %s", v)
+	}
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -24,6 +25,31 @@ var schemaRegionID = &schema.Schema{
 	Description: "Deployment region: https://neon.tech/docs/introduction/regions",
 }
 
+var schemaNameRegex = &schema.Schema{
+	Type:        schema.TypeString,
+	Optional:    true,
+	Description: "Regular expression to filter the results by name.",
+	ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+		if _, err := regexp.Compile(i.(string)); err != nil {
+			errs = append(errs, fmt.Errorf("%s must be valid regular expression: %w", s, err))
+		}
+		return
+	},
+}
+
+// newNameFilter returns the function to filter the results by name using the regular expression `name_regex`.
+func newNameFilter(d *schema.ResourceData) (func(name string) bool, error) {
+	v, ok := d.GetOk("name_regex")
+	if !ok {
+		return func(string) bool { return true }, nil
+	}
+	re, err := regexp.Compile(v.(string))
+	if err != nil {
+		return nil, err
+	}
+	return re.MatchString, nil
+}
+
 type t interface {
 	bool | string | int | int32 | int64 | float64 | float32 | neon.PgVersion | neon.ComputeUnit | neon.Provisioner | neon.EndpointPoolerMode | neon.SuspendTimeoutSeconds
 }
@@ -31,24 +31,55 @@ var p = &schema.Provider{
 		},
 	},
 	ResourcesMap: map[string]*schema.Resource{
-		"neon_api_key":                  resourceAPIKey(),
-		"neon_project":                  resourceProject(),
-		"neon_branch":                   resourceBranch(),
-		"neon_endpoint":                 resourceEndpoint(),
-		"neon_role":                     resourceRole(),
-		"neon_database":                 resourceDatabase(),
-		"neon_project_permission":       resourceProjectPermission(),
-		"neon_jwks_url":                 resourceJwksUrl(),
-		"neon_vpc_endpoint_assignment":  resourceVPCEndpointAssignment(),
-		"neon_vpc_endpoint_restriction": resourceVPCEndpointRestriction(),
-		"neon_org_api_key":              resourceOrgAPIKey(),
+		"neon_api_key":                     resourceAPIKey(),
+		"neon_project":                     resourceProject(),
+		"neon_branch":                      resourceBranch(),
+		"neon_endpoint":                    resourceEndpoint(),
+		"neon_role":                        resourceRole(),
+		"neon_database":                    resourceDatabase(),
+		"neon_project_permission":          resourceProjectPermission(),
+		"neon_project_permissions":         resourceProjectPermissions(),
+		"neon_jwks_url":                    resourceJwksUrl(),
+		"neon_vpc_endpoint_assignment":     resourceVPCEndpointAssignment(),
+		"neon_vpc_endpoint_restriction":    resourceVPCEndpointRestriction(),
+		"neon_org_api_key":                 resourceOrgAPIKey(),
+		"neon_snapshot":                    resourceSnapshot(),
+		"neon_organization_member":         resourceOrganizationMember(),
+		"neon_organization_invitation":     resourceOrganizationInvitation(),
+		"neon_operations_wait":             resourceOperationsWait(),
+		"neon_auth_integration":            resourceAuthIntegration(),
+		"neon_data_api":                    resourceDataAPI(),
+		"neon_postgres_extension":          resourcePostgresExtension(),
+		"neon_postgres_schema":             resourcePostgresSchema(),
+		"neon_sql_migrations":              resourceSQLMigrations(),
+		"neon_database_seed":               resourceDatabaseSeed(),
+		"neon_postgres_publication":        resourcePostgresPublication(),
+		"neon_postgres_policy":             resourcePostgresPolicy(),
+		"neon_postgres_replication_slot":   resourcePostgresReplicationSlot(),
+		"neon_postgres_subscription":       resourcePostgresSubscription(),
+		"neon_postgres_grant":              resourcePostgresGrant(),
+		"neon_postgres_default_privileges": resourcePostgresDefaultPrivileges(),
 	},
 	DataSourcesMap: map[string]*schema.Resource{
-		"neon_project":              dataSourceProject(),
-		"neon_branches":             dataSourceBranches(),
-		"neon_branch_endpoints":     dataSourceBranchEndpoints(),
-		"neon_branch_roles":         dataSourceBranchRoles(),
-		"neon_branch_role_password": dataSourceBranchRolePassword(),
+		"neon_project":                  dataSourceProject(),
+		"neon_projects":                 dataSourceProjects(),
+		"neon_branches":                 dataSourceBranches(),
+		"neon_branch_schema_diff":       dataSourceBranchSchemaDiff(),
+		"neon_branch_endpoints":         dataSourceBranchEndpoints(),
+		"neon_endpoint":                 dataSourceEndpoint(),
+		"neon_branch_roles":             dataSourceBranchRoles(),
+		"neon_branch_databases":         dataSourceBranchDatabases(),
+		"neon_branch_role_password":     dataSourceBranchRolePassword(),
+		"neon_snapshots":                dataSourceSnapshots(),
+		"neon_organization":             dataSourceOrganization(),
+		"neon_project_consumption":      dataSourceProjectConsumption(),
+		"neon_organization_consumption": dataSourceOrganizationConsumption(),
+		"neon_regions":                  dataSourceRegions(),
+		"neon_limits":                   dataSourceLimits(),
+		"neon_operations":               dataSourceOperations(),
+		"neon_vpc_endpoints":            dataSourceVPCEndpoints(),
+		"neon_project_vpc_endpoints":    dataSourceProjectVPCEndpoints(),
+		"neon_sql_query":                dataSourceSQLQuery(),
 	},
 }
 
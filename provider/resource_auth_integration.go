@@ -0,0 +1,309 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func resourceAuthIntegration() *schema.Resource {
+	return &schema.Resource{
+		Description: `Neon Auth integration. See details: https://neon.com/docs/neon-auth/overview
+
+The resource enables the Neon-managed authentication provider for the project's branch.
+The integration is deleted upon the resource's deletion.
+
+~>**WARNING** The keys ` + "`pub_client_key`" + ` and ` + "`secret_server_key`" + ` are only available upon the integration's creation,
+they are not set when the resource is imported.
+`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceAuthIntegrationImport,
+		},
+		CreateContext: resourceAuthIntegrationCreateRetry,
+		ReadContext:   resourceAuthIntegrationReadRetry,
+		UpdateContext: resourceAuthIntegrationUpdate,
+		DeleteContext: resourceAuthIntegrationDeleteRetry,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Project ID.",
+			},
+			"branch_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Branch ID. The project's default branch is used if not set.",
+			},
+			"auth_provider": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     string(neon.NeonAuthSupportedAuthProviderStack),
+				Description: `Authentication provider. Allowed values: "stack", "stack_v2", "better_auth".`,
+				ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+					switch v := neon.NeonAuthSupportedAuthProvider(i.(string)); v {
+					case neon.NeonAuthSupportedAuthProviderStack, neon.NeonAuthSupportedAuthProviderStackV2,
+						neon.NeonAuthSupportedAuthProviderBetterAuth:
+					default:
+						errs = append(errs, errors.New(string(v)+" is not supported value for "+s))
+					}
+					return
+				},
+			},
+			"database_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the database to synchronise the users to.",
+			},
+			"role_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the role to access the database.",
+			},
+			"delete_data": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the users' data upon the resource's deletion.",
+			},
+			// computed fields
+			"auth_provider_project_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the project of the authentication provider.",
+			},
+			"jwks_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The URL that lists the JWKS.",
+			},
+			"base_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Base URL of the authentication provider.",
+			},
+			"pub_client_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Publishable client key.",
+			},
+			"secret_server_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Secret server key.",
+			},
+			"schema_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the schema with the synchronised users.",
+			},
+			"table_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the table with the synchronised users.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the integration was created.",
+			},
+		},
+	}
+}
+
+func updateStateAuthIntegration(d *schema.ResourceData, v neon.NeonAuthIntegration) error {
+	if err := d.Set("branch_id", v.BranchID); err != nil {
+		return err
+	}
+	if err := d.Set("auth_provider", string(v.AuthProvider)); err != nil {
+		return err
+	}
+	if err := d.Set("database_name", v.DbName); err != nil {
+		return err
+	}
+	if err := d.Set("auth_provider_project_id", v.AuthProviderProjectID); err != nil {
+		return err
+	}
+	if err := d.Set("jwks_url", v.JwksURL); err != nil {
+		return err
+	}
+	var baseURL string
+	if v.BaseURL != nil {
+		baseURL = *v.BaseURL
+	}
+	if err := d.Set("base_url", baseURL); err != nil {
+		return err
+	}
+	return d.Set("created_at", v.CreatedAt.Format(time.RFC3339))
+}
+
+func resourceAuthIntegrationCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceAuthIntegrationCreate, ctx, d, meta)
+}
+
+func resourceAuthIntegrationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	projectID := d.Get("project_id").(string)
+	tflog.Trace(ctx, "create Auth Integration", map[string]interface{}{"projectID": projectID})
+
+	client := meta.(sdkNeonAuth)
+
+	branchID := d.Get("branch_id").(string)
+	if branchID == "" {
+		branches, err := listProjectBranches(client, projectID)
+		if err != nil {
+			return err
+		}
+		for _, v := range branches {
+			if v.Default {
+				branchID = v.ID
+				break
+			}
+		}
+		if branchID == "" {
+			return errors.New("no default branch found for the project " + projectID)
+		}
+	}
+
+	authProvider := neon.NeonAuthSupportedAuthProvider(d.Get("auth_provider").(string))
+	resp, err := client.CreateNeonAuthIntegration(neon.NeonAuthCreateIntegrationRequest{
+		AuthProvider: authProvider,
+		BranchID:     branchID,
+		DatabaseName: pointer(d.Get("database_name").(string)),
+		ProjectID:    projectID,
+		RoleName:     pointer(d.Get("role_name").(string)),
+	})
+	if err != nil {
+		return err
+	}
+
+	d.SetId(projectID + "/" + string(authProvider))
+
+	if err := d.Set("pub_client_key", resp.PubClientKey); err != nil {
+		return err
+	}
+	if err := d.Set("secret_server_key", resp.SecretServerKey); err != nil {
+		return err
+	}
+	if err := d.Set("schema_name", resp.SchemaName); err != nil {
+		return err
+	}
+	if err := d.Set("table_name", resp.TableName); err != nil {
+		return err
+	}
+
+	return resourceAuthIntegrationRead(ctx, d, meta)
+}
+
+func resourceAuthIntegrationReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.RetryWithFallback(resourceAuthIntegrationRead, ctx, d, meta, map[int]FallbackFn{
+		http.StatusNotFound: func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			tflog.Debug(ctx, "auth integration not found, removing from state", map[string]interface{}{"id": d.Id()})
+			d.SetId("")
+			return nil
+		}})
+}
+
+func resourceAuthIntegrationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	projectID := d.Get("project_id").(string)
+	tflog.Trace(ctx, "read Auth Integration", map[string]interface{}{"id": d.Id()})
+
+	resp, err := meta.(sdkNeonAuth).ListNeonAuthIntegrations(projectID)
+	if err != nil {
+		return err
+	}
+
+	authProvider := neon.NeonAuthSupportedAuthProvider(d.Get("auth_provider").(string))
+	for _, v := range resp.Data {
+		if v.AuthProvider == authProvider {
+			return updateStateAuthIntegration(d, v)
+		}
+	}
+
+	tflog.Debug(ctx, "auth integration not found, removing from state", map[string]interface{}{"id": d.Id()})
+	d.SetId("")
+	return nil
+}
+
+// resourceAuthIntegrationUpdate only updates the state because the attribute delete_data is used upon deletion.
+func resourceAuthIntegrationUpdate(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceAuthIntegrationDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.RetryWithFallback(resourceAuthIntegrationDelete, ctx, d, meta, map[int]FallbackFn{
+		http.StatusNotFound: func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			d.SetId("")
+			return nil
+		},
+	})
+}
+
+func resourceAuthIntegrationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "delete Auth Integration", map[string]interface{}{"id": d.Id()})
+
+	if err := meta.(sdkNeonAuth).DeleteNeonAuthIntegration(
+		d.Get("project_id").(string),
+		neon.NeonAuthSupportedAuthProvider(d.Get("auth_provider").(string)),
+		&neon.DeleteNeonAuthIntegrationReqObj{DeleteData: pointer(d.Get("delete_data").(bool))},
+	); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourceAuthIntegrationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import Auth Integration")
+
+	els := strings.SplitN(d.Id(), "/", 2)
+	if len(els) != 2 {
+		return nil, fmt.Errorf("invalid identifier, expected {{.ProjectID}}/{{.AuthProvider}}")
+	}
+	if err := d.Set("project_id", els[0]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("auth_provider", els[1]); err != nil {
+		return nil, err
+	}
+	if err := d.Set("delete_data", false); err != nil {
+		return nil, err
+	}
+
+	if diags := projectReadiness.Retry(resourceAuthIntegrationRead, ctx, d, meta); diags.HasError() {
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("no auth integration found")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type sdkNeonAuth interface {
+	CreateNeonAuthIntegration(cfg neon.NeonAuthCreateIntegrationRequest) (neon.NeonAuthCreateIntegrationResponse, error)
+	ListNeonAuthIntegrations(projectID string) (neon.ListNeonAuthIntegrationsResponse, error)
+	DeleteNeonAuthIntegration(projectID string, authProvider neon.NeonAuthSupportedAuthProvider,
+		cfg *neon.DeleteNeonAuthIntegrationReqObj) error
+	sdkBranches
+}
@@ -0,0 +1,97 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_resourceAuthIntegrationCreate(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	t.Run("shall create the integration for the default branch", func(t *testing.T) {
+		definition := resourceAuthIntegration().TestResourceData()
+		_ = definition.Set("project_id", "myproject")
+		_ = definition.Set("auth_provider", "stack")
+
+		meta := &sdkClientStub{
+			stubBranches: stubBranches{
+				Branches: []neon.Branch{{ID: "br-foo"}, {ID: "br-bar", Default: true}},
+			},
+		}
+
+		if err := resourceAuthIntegrationCreate(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if definition.Id() != "myproject/stack" {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+		if v := definition.Get("branch_id").(string); v != "br-bar" {
+			t.Errorf("unexpected branch_id: %s", v)
+		}
+		if v := definition.Get("jwks_url").(string); v == "" {
+			t.Error("jwks_url expected to be set")
+		}
+		if v := definition.Get("secret_server_key").(string); v != "ssk_foo" {
+			t.Errorf("unexpected secret_server_key: %s", v)
+		}
+	})
+
+	t.Run("unhappy path", func(t *testing.T) {
+		definition := resourceAuthIntegration().TestResourceData()
+		_ = definition.Set("project_id", "myproject")
+		_ = definition.Set("auth_provider", "stack")
+		_ = definition.Set("branch_id", "br-foo")
+
+		meta := &sdkClientStub{
+			stubNeonAuth: stubNeonAuth{err: errors.New("foobar")},
+		}
+
+		if err := resourceAuthIntegrationCreate(context.TODO(), definition, meta); err == nil {
+			t.Fatal("error expected")
+		}
+		if definition.Id() != "" {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+	})
+}
+
+func Test_resourceAuthIntegrationDelete(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	definition := resourceAuthIntegration().TestResourceData()
+	definition.SetId("myproject/stack")
+	_ = definition.Set("project_id", "myproject")
+	_ = definition.Set("auth_provider", "stack")
+
+	meta := &sdkClientStub{
+		stubNeonAuth: stubNeonAuth{
+			Integrations: []neon.NeonAuthIntegration{{AuthProvider: neon.NeonAuthSupportedAuthProviderStack}},
+		},
+	}
+
+	if err := resourceAuthIntegrationDelete(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(meta.Integrations) != 0 {
+		t.Error("integration expected to be deleted")
+	}
+	if definition.Id() != "" {
+		t.Errorf("unexpected resource ID: %s", definition.Id())
+	}
+}
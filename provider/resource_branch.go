@@ -26,6 +26,7 @@ func resourceBranch() *schema.Resource {
 		ReadContext:   resourceBranchReadRetry,
 		UpdateContext: resourceBranchUpdateRetry,
 		DeleteContext: resourceBranchDeleteRetry,
+		CustomizeDiff: newHistoryRetentionCheck("parent_timestamp"),
 		Schema: map[string]*schema.Schema{
 			"project_id": {
 				Type:        schema.TypeString,
@@ -49,6 +50,14 @@ func resourceBranch() *schema.Resource {
 				Computed:    true,
 				Description: "ID of the branch to check out.",
 			},
+			"snapshot_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"parent_id", "parent_lsn", "parent_timestamp"},
+				Description:   "ID of the snapshot to restore the branch from.",
+			},
 			"parent_lsn": {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -66,6 +75,7 @@ See details: https://neon.tech/docs/reference/glossary/#lsn`,
 				ValidateFunc:  intValidationNotNegative,
 				ConflictsWith: []string{"parent_lsn"},
 				Description: `Timestamp horizon for the data to be present in the new branch.
+It cannot reach further back than the project's ` + "`history_retention_seconds`" + `.
 **Note**: it's defined as Unix epoch.`,
 			},
 			"logical_size": {
@@ -95,6 +105,11 @@ func updateStateBranch(d *schema.ResourceData, v neon.Branch) error {
 			return err
 		}
 	}
+	if v.RestoredFrom != nil {
+		if err := d.Set("snapshot_id", *v.RestoredFrom); err != nil {
+			return err
+		}
+	}
 	if v.LogicalSize != nil {
 		if err := d.Set("logical_size", int(*v.LogicalSize)); err != nil {
 			return err
@@ -144,6 +159,10 @@ func resourceBranchCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	tflog.Trace(ctx, "created Branch")
 	tflog.Debug(ctx, "create Branch.", map[string]interface{}{"projectID": d.Get("project_id")})
 
+	if v, ok := d.GetOk("snapshot_id"); ok && v.(string) != "" {
+		return resourceBranchCreateFromSnapshot(ctx, d, meta, v.(string))
+	}
+
 	cfg := neon.CreateProjectBranchReqObj{
 		BranchCreateRequest: neon.BranchCreateRequest{
 			Branch: &neon.BranchCreateRequestBranch{
@@ -177,6 +196,42 @@ func resourceBranchCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	return nil
 }
 
+func resourceBranchCreateFromSnapshot(ctx context.Context, d *schema.ResourceData, meta interface{},
+	snapshotID string) error {
+	tflog.Debug(ctx, "restore Branch from Snapshot.", map[string]interface{}{
+		"projectID":  d.Get("project_id"),
+		"snapshotID": snapshotID,
+	})
+
+	projectID := d.Get("project_id").(string)
+	client := meta.(*neon.Client)
+	resp, err := client.RestoreSnapshot(projectID, snapshotID, nil, &neon.RestoreSnapshotReqObj{
+		Name:            pointer(d.Get("name").(string)),
+		FinalizeRestore: pointer(false),
+	})
+	if err != nil {
+		return err
+	}
+	waitUnfinishedOperations(ctx, client, resp.OperationsResponse.Operations)
+	d.SetId(resp.BranchResponse.Branch.ID)
+
+	branch := resp.BranchResponse.Branch
+	if protected := types.GetTristateBool(d, "protected"); protected != nil && *protected != branch.Protected {
+		resp, err := client.UpdateProjectBranch(projectID, branch.ID, neon.BranchUpdateRequest{
+			Branch: neon.BranchUpdateRequestBranch{
+				Protected: protected,
+			},
+		})
+		if err != nil {
+			return err
+		}
+		waitUnfinishedOperations(ctx, client, resp.OperationsResponse.Operations)
+		branch = resp.BranchResponse.Branch
+	}
+
+	return updateStateBranch(d, branch)
+}
+
 func resourceBranchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	tflog.Trace(ctx, "update Branch")
 
@@ -276,6 +331,48 @@ func resourceBranchImport(ctx context.Context, d *schema.ResourceData, meta inte
 	return []*schema.ResourceData{d}, nil
 }
 
+type sdkProjectReader interface {
+	GetProject(string) (neon.ProjectResponse, error)
+}
+
+// newHistoryRetentionCheck verifies that the point in time defined by the attribute timestampKey
+// is within the project's history retention window.
+func newHistoryRetentionCheck(timestampKey string) schema.CustomizeDiffFunc {
+	return func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+		if !d.HasChange(timestampKey) || !d.NewValueKnown(timestampKey) || !d.NewValueKnown("project_id") {
+			return nil
+		}
+
+		v, ok := d.GetOk(timestampKey)
+		if !ok || v.(int) <= 0 {
+			return nil
+		}
+
+		projectID := d.Get("project_id").(string)
+		tflog.Trace(ctx, "check history retention", map[string]interface{}{"projectID": projectID})
+
+		resp, err := meta.(sdkProjectReader).GetProject(projectID)
+		if err != nil {
+			return err
+		}
+
+		return checkHistoryRetention(
+			timestampKey, time.Unix(int64(v.(int)), 0), resp.Project.HistoryRetentionSeconds, time.Now(),
+		)
+	}
+}
+
+func checkHistoryRetention(key string, ts time.Time, historyRetentionSeconds int32, now time.Time) error {
+	horizon := now.Add(-time.Duration(historyRetentionSeconds) * time.Second)
+	if ts.Before(horizon) {
+		return fmt.Errorf(
+			"%s %s is beyond the project's history retention window of %d seconds, the earliest allowed value is %d",
+			key, ts.UTC().Format(time.RFC3339), historyRetentionSeconds, horizon.Unix(),
+		)
+	}
+	return nil
+}
+
 func isValidBranchID(s string) bool {
 	const prefix = "br-"
 	return strings.HasPrefix(s, prefix) && len(strings.TrimPrefix(s, prefix)) > 0
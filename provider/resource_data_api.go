@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func resourceDataAPI() *schema.Resource {
+	return &schema.Resource{
+		Description: `Neon Data API of the branch's database. See details: https://neon.com/docs/data-api/get-started
+
+The Data API is deleted upon the resource's deletion.
+The exposed schemas and the anonymous role settings are not configurable yet because the Neon API client
+does not support them.
+
+~>**NOTE** The authentication settings cannot be read from the Neon API, hence they are not set when the resource is imported.
+`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceDataAPIImport,
+		},
+		CreateContext: resourceDataAPICreateRetry,
+		ReadContext:   resourceDataAPIReadRetry,
+		DeleteContext: resourceDataAPIDeleteRetry,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Project ID.",
+			},
+			"branch_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Branch ID.",
+			},
+			"database_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the database to expose via the Data API.",
+			},
+			"auth_provider": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: `Authentication provider. Allowed values: "neon_auth", "external".`,
+				ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+					switch v := i.(string); v {
+					case "neon_auth", "external":
+					default:
+						errs = append(errs, errors.New(v+" is not supported value for "+s))
+					}
+					return
+				},
+			},
+			"jwks_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The URL that lists the JWKS of the external authentication provider.",
+			},
+			"provider_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Name of the external authentication provider, e.g. Clerk, Stytch, Auth0.",
+			},
+			"jwt_audience": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: `Audience claim of the JWT.
+Note that only the tokens with a different audience claim are rejected, the tokens without the audience claim are accepted.`,
+			},
+			"add_default_grants": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Grant all permissions on the tables in the schema public to the authenticated users.",
+			},
+			// computed fields
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "URL of the Data API.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the Data API deployment.",
+			},
+		},
+	}
+}
+
+func updateStateDataAPI(d *schema.ResourceData, v neon.DataAPIReponse) error {
+	if err := d.Set("url", v.URL); err != nil {
+		return err
+	}
+	return d.Set("status", v.Status)
+}
+
+func resourceDataAPICreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceDataAPICreate, ctx, d, meta)
+}
+
+func resourceDataAPICreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	r := complexID{
+		ProjectID: d.Get("project_id").(string),
+		BranchID:  d.Get("branch_id").(string),
+		Name:      d.Get("database_name").(string),
+	}
+	tflog.Trace(ctx, "create Data API", map[string]interface{}{"id": r.toString()})
+
+	resp, err := meta.(sdkDataAPI).CreateProjectBranchDataAPI(r.ProjectID, r.BranchID, r.Name,
+		&neon.DataAPICreateRequest{
+			AddDefaultGrants: pointer(d.Get("add_default_grants").(bool)),
+			AuthProvider:     pointer(d.Get("auth_provider").(string)),
+			JwksURL:          pointer(d.Get("jwks_url").(string)),
+			JwtAudience:      pointer(d.Get("jwt_audience").(string)),
+			ProviderName:     pointer(d.Get("provider_name").(string)),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(r.toString())
+	if err := d.Set("url", resp.URL); err != nil {
+		return err
+	}
+	return resourceDataAPIRead(ctx, d, meta)
+}
+
+func resourceDataAPIReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.RetryWithFallback(resourceDataAPIRead, ctx, d, meta, map[int]FallbackFn{
+		http.StatusNotFound: func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			tflog.Debug(ctx, "data API not found, removing from state", map[string]interface{}{"id": d.Id()})
+			d.SetId("")
+			return nil
+		}})
+}
+
+func resourceDataAPIRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "read Data API", map[string]interface{}{"id": d.Id()})
+
+	resp, err := meta.(sdkDataAPI).GetProjectBranchDataAPI(
+		d.Get("project_id").(string), d.Get("branch_id").(string), d.Get("database_name").(string),
+	)
+	if err != nil {
+		return err
+	}
+	return updateStateDataAPI(d, resp)
+}
+
+func resourceDataAPIDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.RetryWithFallback(resourceDataAPIDelete, ctx, d, meta, map[int]FallbackFn{
+		http.StatusNotFound: func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			d.SetId("")
+			return nil
+		},
+	})
+}
+
+func resourceDataAPIDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "delete Data API", map[string]interface{}{"id": d.Id()})
+
+	if _, err := meta.(sdkDataAPI).DeleteProjectBranchDataAPI(
+		d.Get("project_id").(string), d.Get("branch_id").(string), d.Get("database_name").(string),
+	); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourceDataAPIImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import Data API")
+
+	r, err := parseComplexID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	_ = d.Set("project_id", r.ProjectID)
+	_ = d.Set("branch_id", r.BranchID)
+	_ = d.Set("database_name", r.Name)
+	_ = d.Set("add_default_grants", false)
+
+	if diags := projectReadiness.Retry(resourceDataAPIRead, ctx, d, meta); diags.HasError() {
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type sdkDataAPI interface {
+	CreateProjectBranchDataAPI(projectID string, branchID string, databaseName string, cfg *neon.DataAPICreateRequest) (
+		neon.DataAPICreateResponse, error)
+	GetProjectBranchDataAPI(projectID string, branchID string, databaseName string) (neon.DataAPIReponse, error)
+	DeleteProjectBranchDataAPI(projectID string, branchID string, databaseName string) (neon.EmptyResponse, error)
+}
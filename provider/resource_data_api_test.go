@@ -0,0 +1,93 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_resourceDataAPICreate(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	t.Run("shall enable the Data API for the branch's database", func(t *testing.T) {
+		definition := resourceDataAPI().TestResourceData()
+		_ = definition.Set("project_id", "myproject")
+		_ = definition.Set("branch_id", "br-foo")
+		_ = definition.Set("database_name", "neondb")
+		_ = definition.Set("auth_provider", "neon_auth")
+
+		meta := &sdkClientStub{}
+
+		if err := resourceDataAPICreate(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if want := "myproject/br-foo/neondb"; definition.Id() != want {
+			t.Errorf("unexpected resource ID: want=%s, got=%s", want, definition.Id())
+		}
+		if want := "https://br-foo.dataapi.neon.tech/neondb"; definition.Get("url").(string) != want {
+			t.Errorf("unexpected url: want=%s, got=%s", want, definition.Get("url"))
+		}
+		if v := definition.Get("status").(string); v != "ready" {
+			t.Errorf("unexpected status: %s", v)
+		}
+	})
+
+	t.Run("unhappy path", func(t *testing.T) {
+		definition := resourceDataAPI().TestResourceData()
+		_ = definition.Set("project_id", "myproject")
+		_ = definition.Set("branch_id", "br-foo")
+		_ = definition.Set("database_name", "neondb")
+
+		meta := &sdkClientStub{
+			stubDataAPI: stubDataAPI{err: errors.New("foobar")},
+		}
+
+		if err := resourceDataAPICreate(context.TODO(), definition, meta); err == nil {
+			t.Fatal("error expected")
+		}
+		if definition.Id() != "" {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+	})
+}
+
+func Test_resourceDataAPIDelete(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	definition := resourceDataAPI().TestResourceData()
+	definition.SetId("myproject/br-foo/neondb")
+	_ = definition.Set("project_id", "myproject")
+	_ = definition.Set("branch_id", "br-foo")
+	_ = definition.Set("database_name", "neondb")
+
+	meta := &sdkClientStub{
+		stubDataAPI: stubDataAPI{
+			DataAPIs: map[string]neon.DataAPIReponse{"myproject/br-foo/neondb": {Status: "ready"}},
+		},
+	}
+
+	if err := resourceDataAPIDelete(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(meta.DataAPIs) != 0 {
+		t.Error("data API expected to be deleted")
+	}
+	if definition.Id() != "" {
+		t.Errorf("unexpected resource ID: %s", definition.Id())
+	}
+}
@@ -3,17 +3,26 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"regexp"
+	"slices"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
 	neon "github.com/kislerdm/neon-sdk-go"
 )
 
 func resourceDatabase() *schema.Resource {
 	return &schema.Resource{
-		Description:   `Project Database. See details: https://neon.tech/docs/manage/databases/`,
+		Description: `Project Database. See details: https://neon.tech/docs/manage/databases/
+
+The settings are managed via SQL. The resource connects to the database as its owner
+via the branch's read-write endpoint, hence the endpoint must exist if the settings are set.
+`,
 		SchemaVersion: 7,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceDatabaseImport,
@@ -43,13 +52,136 @@ func resourceDatabase() *schema.Resource {
 			"owner_name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "Role name of the database owner.",
 			},
+			"settings": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: `Configuration parameters of the database, e.g. statement_timeout, search_path, timezone.
+See details: https://www.postgresql.org/docs/current/sql-alterdatabase.html`,
+				ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+					for k := range i.(map[string]interface{}) {
+						if !reDatabaseSettingName.MatchString(k) {
+							errs = append(errs, fmt.Errorf("%s is not valid name of the setting in %s", k, s))
+						}
+					}
+					return
+				},
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					if !slices.Contains(postgresListSettings, strings.TrimPrefix(k, "settings.")) {
+						return false
+					}
+					return slices.Equal(parsePostgresListSetting(oldValue), parsePostgresListSetting(newValue))
+				},
+			},
 		},
 	}
 }
 
+var reDatabaseSettingName = regexp.MustCompile(`^[a-z_][a-z0-9_]*(\.[a-z_][a-z0-9_]*)?$`)
+
+// postgresListSettings defines the settings which values are the lists of identifiers.
+var postgresListSettings = []string{"search_path", "temp_tablespaces"}
+
+func newDatabaseSettingValue(key, value string) string {
+	if !slices.Contains(postgresListSettings, key) {
+		return quoteLiteral(value)
+	}
+	var o []string
+	for _, v := range parsePostgresListSetting(value) {
+		o = append(o, quoteIdentifier(v))
+	}
+	return strings.Join(o, ", ")
+}
+
+func newDatabaseSettingsStatements(name string, oldSettings, newSettings map[string]interface{}) []string {
+	var (
+		o    []string
+		keys []string
+	)
+	for k := range oldSettings {
+		if _, ok := newSettings[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		o = append(o, "ALTER DATABASE "+quoteIdentifier(name)+" RESET "+k)
+	}
+
+	keys = keys[:0]
+	for k, v := range newSettings {
+		if oldSettings[k] != v {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		o = append(o,
+			"ALTER DATABASE "+quoteIdentifier(name)+" SET "+k+" TO "+newDatabaseSettingValue(k, newSettings[k].(string)),
+		)
+	}
+	return o
+}
+
+// readDatabaseSettings reads the database settings which apply to all roles.
+func readDatabaseSettings(ctx context.Context, conn *pgx.Conn, name string) (map[string]interface{}, error) {
+	var setconfig []string
+	if err := conn.QueryRow(ctx,
+		`SELECT COALESCE(
+	(SELECT s.setconfig
+	FROM pg_catalog.pg_db_role_setting s
+	JOIN pg_catalog.pg_database db ON db.oid = s.setdatabase
+	WHERE db.datname = $1 AND s.setrole = 0),
+	'{}'
+)`,
+		name,
+	).Scan(&setconfig); err != nil {
+		return nil, err
+	}
+
+	var o = make(map[string]interface{}, len(setconfig))
+	for _, el := range setconfig {
+		k, v, _ := strings.Cut(el, "=")
+		// the settings' names are case-insensitive, but stored in the canonical form, e.g. TimeZone
+		k = strings.ToLower(k)
+		if slices.Contains(postgresListSettings, k) {
+			v = strings.Join(parsePostgresListSetting(v), ", ")
+		}
+		o[k] = v
+	}
+	return o, nil
+}
+
+func newDatabaseSQLTarget(d *schema.ResourceData) sqlTarget {
+	return sqlTarget{
+		ProjectID: d.Get("project_id").(string),
+		BranchID:  d.Get("branch_id").(string),
+		Database:  d.Get("name").(string),
+	}
+}
+
+// applyDatabaseSettings alters the database settings, and reads them back.
+func applyDatabaseSettings(ctx context.Context, d *schema.ResourceData, meta interface{},
+	oldSettings, newSettings map[string]interface{}) error {
+	name := d.Get("name").(string)
+	tflog.Trace(ctx, "alter Database settings", map[string]interface{}{"name": name})
+
+	return withSQLConn(ctx, meta, newDatabaseSQLTarget(d), func(conn *pgx.Conn) error {
+		for _, q := range newDatabaseSettingsStatements(name, oldSettings, newSettings) {
+			if _, err := conn.Exec(ctx, q); err != nil {
+				return err
+			}
+		}
+		v, err := readDatabaseSettings(ctx, conn, name)
+		if err != nil {
+			return err
+		}
+		return d.Set("settings", v)
+	})
+}
+
 func updateStateDatabase(d *schema.ResourceData, v neon.Database) error {
 	if err := d.Set("owner_name", v.OwnerName); err != nil {
 		return err
@@ -85,7 +217,14 @@ func resourceDatabaseCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	d.SetId(r.toString())
 
-	return updateStateDatabase(d, resp.DatabaseResponse.Database)
+	if err := updateStateDatabase(d, resp.DatabaseResponse.Database); err != nil {
+		return err
+	}
+
+	if v := d.Get("settings").(map[string]interface{}); len(v) > 0 {
+		return applyDatabaseSettings(ctx, d, meta, nil, v)
+	}
+	return nil
 }
 
 func resourceDatabaseReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -112,7 +251,20 @@ func resourceDatabaseRead(ctx context.Context, d *schema.ResourceData, meta inte
 		return err
 	}
 
-	return updateStateDatabase(d, resp.Database)
+	if err := updateStateDatabase(d, resp.Database); err != nil {
+		return err
+	}
+
+	if len(d.Get("settings").(map[string]interface{})) > 0 {
+		return withSQLConn(ctx, meta, newDatabaseSQLTarget(d), func(conn *pgx.Conn) error {
+			v, err := readDatabaseSettings(ctx, conn, d.Get("name").(string))
+			if err != nil {
+				return err
+			}
+			return d.Set("settings", v)
+		})
+	}
+	return nil
 }
 
 func resourceDatabaseUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -127,23 +279,33 @@ func resourceDatabaseUpdate(ctx context.Context, d *schema.ResourceData, meta in
 		panic(err)
 	}
 
-	client := meta.(*neon.Client)
-	resp, err := client.UpdateProjectBranchDatabase(
-		r.ProjectID, r.BranchID, r.Name,
-		neon.DatabaseUpdateRequest{
-			Database: neon.DatabaseUpdateRequestDatabase{
-				Name:      pointer(d.Get("name").(string)),
-				OwnerName: pointer(d.Get("owner_name").(string)),
+	if d.HasChanges("name", "owner_name") {
+		client := meta.(*neon.Client)
+		resp, err := client.UpdateProjectBranchDatabase(
+			r.ProjectID, r.BranchID, r.Name,
+			neon.DatabaseUpdateRequest{
+				Database: neon.DatabaseUpdateRequestDatabase{
+					Name:      pointer(d.Get("name").(string)),
+					OwnerName: pointer(d.Get("owner_name").(string)),
+				},
 			},
-		},
-	)
-	if err != nil {
-		return err
+		)
+		if err != nil {
+			return err
+		}
+		waitUnfinishedOperations(ctx, client, resp.OperationsResponse.Operations)
+		r.Name = resp.DatabaseResponse.Database.Name
+		d.SetId(r.toString())
+		if err := updateStateDatabase(d, resp.Database); err != nil {
+			return err
+		}
 	}
-	waitUnfinishedOperations(ctx, client, resp.OperationsResponse.Operations)
-	r.Name = resp.DatabaseResponse.Database.Name
-	d.SetId(r.toString())
-	return updateStateDatabase(d, resp.Database)
+
+	if d.HasChange("settings") {
+		o, n := d.GetChange("settings")
+		return applyDatabaseSettings(ctx, d, meta, o.(map[string]interface{}), n.(map[string]interface{}))
+	}
+	return nil
 }
 
 func resourceDatabaseDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+func resourceDatabaseSeed() *schema.Resource {
+	return &schema.Resource{
+		Description: `Loads the plain-SQL dump into the database of the branch, e.g. to provide the reference data
+for the preview branch created from the empty parent branch.
+
+The dump is read as the stream of statements, the data of the statements ` + "`COPY ... FROM stdin`" + `
+is streamed to the database, hence the dump's size is not limited by the memory.
+The dump is loaded in a single transaction, the psql meta-commands, e.g. ` + "`\\connect`" + `, are skipped.
+The statements which cannot run in the transaction block, e.g. CREATE DATABASE, are not supported.
+
+The dump is loaded again when the file's SHA256 checksum, or the values of the attribute ` + "`triggers`" + ` change.
+The resource connects to the database via the branch's read-write endpoint, hence the endpoint must exist.
+The resource's deletion only removes it from the state, the loaded data are not deleted.
+`,
+		SchemaVersion: 1,
+		CreateContext: resourceDatabaseSeedCreateRetry,
+		ReadContext:   resourceDatabaseSeedRead,
+		UpdateContext: resourceDatabaseSeedUpdate,
+		DeleteContext: resourceDatabaseSeedDelete,
+		CustomizeDiff: resourceDatabaseSeedCustomizeDiff,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: newSchemaSQLTarget(map[string]*schema.Schema{
+			"file": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path to the plain-SQL dump, e.g. generated by pg_dump --format=plain.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values which trigger the load when changed.",
+			},
+			"file_sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 checksum of the loaded dump.",
+			},
+			"rows_loaded": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeInt},
+				Description: `Number of rows loaded per table by the statements COPY and INSERT.
+The table name is defined as it is written in the dump, e.g. "public.users".`,
+			},
+		}),
+	}
+}
+
+func newFileSHA256(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+func resourceDatabaseSeedCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if !d.NewValueKnown("file") {
+		return d.SetNewComputed("file_sha256")
+	}
+
+	checksum, err := newFileSHA256(d.Get("file").(string))
+	if err != nil {
+		return err
+	}
+
+	if d.Id() == "" {
+		return d.SetNew("file_sha256", checksum)
+	}
+	if checksum == d.Get("file_sha256").(string) {
+		return nil
+	}
+
+	tflog.Debug(ctx, "checksum of the database seed changed", map[string]interface{}{"id": d.Id()})
+	if err := d.SetNew("file_sha256", checksum); err != nil {
+		return err
+	}
+	return d.ForceNew("file_sha256")
+}
+
+// sqlDumpScanner tracks the lexical context of the dump's statement across the lines.
+type sqlDumpScanner struct {
+	// quote is the opening character of the quoted literal, or identifier.
+	quote byte
+	// escape defines if the quoted literal is the escape string, e.g. E'it\'s'.
+	escape bool
+	// dollarTag is the opening tag of the dollar-quoted string, e.g. $$, or $body$.
+	dollarTag string
+	// comment is the depth of the nested block comments.
+	comment int
+	// content defines if the statement contains the characters other than the whitespaces and the comments.
+	content bool
+}
+
+var reSQLDollarTag = regexp.MustCompile(`^\$([A-Za-z_][A-Za-z0-9_]*)?\$`)
+
+func isSQLIdentifierChar(c byte) bool {
+	return c == '_' || c == '$' || (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+// scan writes the line's part belonging to the statement to b, the comments and the whitespaces preceding
+// the statement are omitted. It returns the index following the statement's terminating semicolon,
+// or -1 if the statement continues on the next line.
+func (s *sqlDumpScanner) scan(line string, b *strings.Builder) int {
+	start := 0
+	if !s.content {
+		start = len(line)
+	}
+
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		switch {
+		case s.comment > 0:
+			switch {
+			case strings.HasPrefix(line[i:], "*/"):
+				s.comment--
+				i++
+			case strings.HasPrefix(line[i:], "/*"):
+				s.comment++
+				i++
+			}
+
+		case s.quote != 0:
+			switch {
+			case s.escape && c == '\\':
+				i++
+			case c == s.quote:
+				s.quote = 0
+				s.escape = false
+			}
+
+		case s.dollarTag != "":
+			if strings.HasPrefix(line[i:], s.dollarTag) {
+				i += len(s.dollarTag) - 1
+				s.dollarTag = ""
+			}
+
+		case strings.HasPrefix(line[i:], "--"):
+			i = len(line)
+
+		case strings.HasPrefix(line[i:], "/*"):
+			s.comment = 1
+			i++
+
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+
+		default:
+			if !s.content {
+				s.content = true
+				start = i
+			}
+
+			switch c {
+			case '\'', '"':
+				s.quote = c
+				s.escape = c == '\'' && i > 0 && (line[i-1] == 'E' || line[i-1] == 'e') &&
+					(i == 1 || !isSQLIdentifierChar(line[i-2]))
+			case '$':
+				if i > 0 && isSQLIdentifierChar(line[i-1]) {
+					continue
+				}
+				if tag := reSQLDollarTag.FindString(line[i:]); tag != "" {
+					s.dollarTag = tag
+					i += len(tag) - 1
+				}
+			case ';':
+				b.WriteString(line[start : i+1])
+				return i + 1
+			}
+		}
+	}
+
+	if start < len(line) {
+		b.WriteString(line[start:])
+	}
+	return -1
+}
+
+// sqlDumpReader reads the statements of the plain-SQL dump one by one.
+type sqlDumpReader struct {
+	r *bufio.Reader
+	// rest is the line's part following the last read statement.
+	rest string
+}
+
+func newSQLDumpReader(r io.Reader) *sqlDumpReader {
+	return &sqlDumpReader{r: bufio.NewReader(r)}
+}
+
+// readLine returns the next line including the line break, or io.EOF if no lines left.
+func (r *sqlDumpReader) readLine() (string, error) {
+	if r.rest != "" {
+		v := r.rest
+		r.rest = ""
+		return v, nil
+	}
+
+	v, err := r.r.ReadString('\n')
+	if errors.Is(err, io.EOF) && v != "" {
+		return v, nil
+	}
+	return v, err
+}
+
+// nextStatement returns the next statement, or io.EOF if no statements left.
+func (r *sqlDumpReader) nextStatement() (string, error) {
+	var (
+		b strings.Builder
+		s sqlDumpScanner
+	)
+	for {
+		fromRest := r.rest != ""
+		line, err := r.readLine()
+		if errors.Is(err, io.EOF) && s.content {
+			return b.String(), nil
+		}
+		if err != nil {
+			return "", err
+		}
+
+		// the psql meta-command takes the whole line
+		if !fromRest && !s.content && s.comment == 0 && strings.HasPrefix(strings.TrimLeft(line, " \t"), `\`) {
+			continue
+		}
+
+		if i := s.scan(line, &b); i >= 0 {
+			r.rest = line[i:]
+			return b.String(), nil
+		}
+	}
+}
+
+// copyData writes the data of the statement COPY ... FROM stdin to w until the end-of-data marker "\.".
+func (r *sqlDumpReader) copyData(w io.Writer) error {
+	// the data start on the line following the statement
+	r.rest = ""
+	for {
+		line, err := r.r.ReadString('\n')
+		if strings.TrimRight(line, "\r\n") == `\.` {
+			return nil
+		}
+		if errors.Is(err, io.EOF) {
+			return errors.New(`no end-of-data marker "\." found for COPY`)
+		}
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(w, line); err != nil {
+			return err
+		}
+	}
+}
+
+const sqlQualifiedNamePattern = `((?:"(?:[^"]|"")*"|[^\s(."]+)(?:\.(?:"(?:[^"]|"")*"|[^\s(."]+))*)`
+
+var (
+	reSQLDumpCopy   = regexp.MustCompile(`(?is)^COPY\s+` + sqlQualifiedNamePattern + `.*\sFROM\s+stdin\b`)
+	reSQLDumpInsert = regexp.MustCompile(`(?is)^INSERT\s+INTO\s+` + sqlQualifiedNamePattern)
+)
+
+// copySQLDumpData streams the data of the statement COPY ... FROM stdin to the database,
+// and returns the number of copied rows.
+func copySQLDumpData(ctx context.Context, conn *pgconn.PgConn, statement string, dump *sqlDumpReader) (int64, error) {
+	pr, pw := io.Pipe()
+
+	type result struct {
+		tag pgconn.CommandTag
+		err error
+	}
+	done := make(chan result, 1)
+	go func() {
+		tag, err := conn.CopyFrom(ctx, pr, statement)
+		_ = pr.CloseWithError(err)
+		done <- result{tag: tag, err: err}
+	}()
+
+	errData := dump.copyData(pw)
+	_ = pw.CloseWithError(errData)
+
+	res := <-done
+	if res.err != nil {
+		return 0, res.err
+	}
+	if errData != nil {
+		return 0, errData
+	}
+	return res.tag.RowsAffected(), nil
+}
+
+// seedDatabase loads the dump in a single transaction, and returns the number of rows loaded per table.
+func seedDatabase(ctx context.Context, conn *pgx.Conn, r io.Reader) (map[string]int64, error) {
+	var (
+		rows = map[string]int64{}
+		dump = newSQLDumpReader(r)
+	)
+	err := pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+		for n := 1; ; n++ {
+			q, err := dump.nextStatement()
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+
+			if m := reSQLDumpCopy.FindStringSubmatch(q); m != nil {
+				v, err := copySQLDumpData(ctx, tx.Conn().PgConn(), q, dump)
+				if err != nil {
+					return fmt.Errorf("statement %d failed: %w", n, err)
+				}
+				rows[m[1]] += v
+				continue
+			}
+
+			tag, err := tx.Exec(ctx, q, pgx.QueryExecModeSimpleProtocol)
+			if err != nil {
+				return fmt.Errorf("statement %d failed: %w", n, err)
+			}
+			if m := reSQLDumpInsert.FindStringSubmatch(q); m != nil && tag.Insert() {
+				rows[m[1]] += tag.RowsAffected()
+			}
+		}
+	})
+	return rows, err
+}
+
+func resourceDatabaseSeedCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceDatabaseSeedCreate, ctx, d, meta)
+}
+
+func resourceDatabaseSeedCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	t := newSQLTarget(d)
+	path := d.Get("file").(string)
+	r := newSQLObjectID(t, filepath.Base(path))
+	tflog.Trace(ctx, "create Database Seed", map[string]interface{}{"id": r.toString()})
+
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = f.Close() }()
+
+	var (
+		h    = sha256.New()
+		rows map[string]int64
+	)
+	if err := withSQLConn(ctx, meta, t, func(conn *pgx.Conn) error {
+		var err error
+		rows, err = seedDatabase(ctx, conn, io.TeeReader(f, h))
+		return err
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(r.toString())
+	if err := d.Set("file_sha256", hex.EncodeToString(h.Sum(nil))); err != nil {
+		return err
+	}
+
+	var o = make(map[string]interface{}, len(rows))
+	for k, v := range rows {
+		o[k] = int(v)
+	}
+	return d.Set("rows_loaded", o)
+}
+
+func resourceDatabaseSeedRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+// resourceDatabaseSeedUpdate does not load the dump, because only the role to connect to the database as can change.
+func resourceDatabaseSeedUpdate(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceDatabaseSeedDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "database seed cannot be reverted, removing from state", map[string]interface{}{"id": d.Id()})
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,189 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func Test_sqlDumpReader(t *testing.T) {
+	const dump = `--
+-- PostgreSQL database dump
+--
+\restrict foo
+
+SET statement_timeout = 0;
+SELECT pg_catalog.set_config('search_path', '', false); SET client_encoding = 'UTF8';
+
+/* the function
+   body */
+CREATE FUNCTION public.add(a integer, b integer) RETURNS integer
+    LANGUAGE sql
+    AS $_$SELECT $1 + $2;$_$;
+
+CREATE TABLE public."User;s" (
+    id integer, -- identifier;
+    name text DEFAULT E'it\'s;'
+);
+
+--
+-- Data for Name: User;s
+--
+
+COPY public."User;s" (id, name) FROM stdin;
+1	foo;
+2	\N
+\.
+
+INSERT INTO public."User;s" VALUES (3, 'bar;'), (4, $$baz;$$);
+SELECT 1`
+
+	r := newSQLDumpReader(strings.NewReader(dump))
+
+	var got []string
+	for {
+		v, err := r.nextStatement()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got = append(got, v)
+
+		if reSQLDumpCopy.MatchString(v) {
+			var b strings.Builder
+			if err := r.copyData(&b); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if want := "1\tfoo;\n2\t\\N\n"; b.String() != want {
+				t.Errorf("unexpected COPY data: want=%q, got=%q", want, b.String())
+			}
+		}
+	}
+
+	want := []string{
+		"SET statement_timeout = 0;",
+		"SELECT pg_catalog.set_config('search_path', '', false);",
+		"SET client_encoding = 'UTF8';",
+		`CREATE FUNCTION public.add(a integer, b integer) RETURNS integer
+    LANGUAGE sql
+    AS $_$SELECT $1 + $2;$_$;`,
+		`CREATE TABLE public."User;s" (
+    id integer, -- identifier;
+    name text DEFAULT E'it\'s;'
+);`,
+		`COPY public."User;s" (id, name) FROM stdin;`,
+		`INSERT INTO public."User;s" VALUES (3, 'bar;'), (4, $$baz;$$);`,
+		"SELECT 1",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected statements:\nwant=%q\ngot=%q", want, got)
+	}
+
+	t.Run("shall fail if COPY data are not terminated", func(t *testing.T) {
+		r := newSQLDumpReader(strings.NewReader("COPY public.foo FROM stdin;\n1\n"))
+		if _, err := r.nextStatement(); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := r.copyData(io.Discard); err == nil {
+			t.Error("error expected")
+		}
+	})
+}
+
+func Test_reSQLDump(t *testing.T) {
+	tests := []struct {
+		statement  string
+		wantCopy   string
+		wantInsert string
+	}{
+		{statement: `COPY public.users (id, name) FROM stdin;`, wantCopy: "public.users"},
+		{statement: `copy "App"."User s" from stdin with (format csv);`, wantCopy: `"App"."User s"`},
+		{statement: `COPY (SELECT 1) TO stdout;`},
+		{statement: `COPY users TO stdout;`},
+		{statement: `INSERT INTO public.users(id) VALUES (1);`, wantInsert: "public.users"},
+		{statement: "INSERT INTO users\nVALUES (1);", wantInsert: "users"},
+		{statement: `SELECT 1;`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.statement, func(t *testing.T) {
+			var gotCopy, gotInsert string
+			if m := reSQLDumpCopy.FindStringSubmatch(tt.statement); m != nil {
+				gotCopy = m[1]
+			}
+			if m := reSQLDumpInsert.FindStringSubmatch(tt.statement); m != nil {
+				gotInsert = m[1]
+			}
+			if gotCopy != tt.wantCopy {
+				t.Errorf("unexpected COPY table: want=%s, got=%s", tt.wantCopy, gotCopy)
+			}
+			if gotInsert != tt.wantInsert {
+				t.Errorf("unexpected INSERT table: want=%s, got=%s", tt.wantInsert, gotInsert)
+			}
+		})
+	}
+}
+
+func Test_resourceDatabaseSeed(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	meta := newTestSQLMeta(t)
+	t.Cleanup(func() {
+		execTestSQL(t, meta, `DROP TABLE IF EXISTS seed_test_users`)
+	})
+
+	path := filepath.Join(t.TempDir(), "seed.sql")
+	if err := os.WriteFile(path, []byte(`CREATE TABLE seed_test_users (id int, name text);
+COPY public.seed_test_users (id, name) FROM stdin;
+1	foo
+2	bar
+\.
+INSERT INTO public.seed_test_users VALUES (3, 'baz');
+`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	definition := resourceDatabaseSeed().TestResourceData()
+	_ = definition.Set("project_id", "myproject")
+	_ = definition.Set("branch_id", "br-foo")
+	_ = definition.Set("database", "postgres")
+	_ = definition.Set("file", path)
+
+	if err := resourceDatabaseSeedCreate(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "myproject/br-foo/postgres/seed.sql"; definition.Id() != want {
+		t.Errorf("unexpected resource ID: want=%s, got=%s", want, definition.Id())
+	}
+	if got, want := definition.Get("rows_loaded"), map[string]interface{}{"public.seed_test_users": 3}; !reflect.DeepEqual(
+		got, want,
+	) {
+		t.Errorf("unexpected rows loaded: want=%v, got=%v", want, got)
+	}
+	if want, _ := newFileSHA256(path); definition.Get("file_sha256") != want {
+		t.Errorf("unexpected checksum: want=%s, got=%v", want, definition.Get("file_sha256"))
+	}
+
+	t.Run("shall roll back the failed seed", func(t *testing.T) {
+		if err := os.WriteFile(path, []byte(`INSERT INTO seed_test_users VALUES (4, 'qux');
+SELECT foo FROM bar;
+`), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		if err := resourceDatabaseSeedCreate(context.TODO(), definition, meta); err == nil {
+			t.Fatal("error expected")
+		}
+	})
+}
@@ -0,0 +1,98 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func Test_newDatabaseSettingsStatements(t *testing.T) {
+	got := newDatabaseSettingsStatements("foo",
+		map[string]interface{}{"statement_timeout": "30s", "timezone": "UTC", "work_mem": "4MB"},
+		map[string]interface{}{"statement_timeout": "60s", "timezone": "UTC", "search_path": "$user, public"},
+	)
+	want := []string{
+		`ALTER DATABASE "foo" RESET work_mem`,
+		`ALTER DATABASE "foo" SET search_path TO "$user", "public"`,
+		`ALTER DATABASE "foo" SET statement_timeout TO '60s'`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("newDatabaseSettingsStatements() = %v, want %v", got, want)
+	}
+}
+
+func Test_resourceDatabase_settingsValidation(t *testing.T) {
+	validate := resourceDatabase().Schema["settings"].ValidateFunc
+
+	if _, errs := validate(map[string]interface{}{"statement_timeout": "30s", "app.tenant": "foo"}, "settings"); len(errs) > 0 {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+	if _, errs := validate(map[string]interface{}{"timezone TO 'UTC'; DROP TABLE foo; --": ""}, "settings"); len(errs) == 0 {
+		t.Error("error expected")
+	}
+}
+
+func Test_resourceDatabase_settingsDiffSuppress(t *testing.T) {
+	suppress := resourceDatabase().Schema["settings"].DiffSuppressFunc
+
+	if !suppress("settings.search_path", `$user, public`, `"$user",public`, nil) {
+		t.Error("equal search paths expected to be suppressed")
+	}
+	if suppress("settings.search_path", `$user, public`, `public`, nil) {
+		t.Error("different search paths are not expected to be suppressed")
+	}
+	if suppress("settings.statement_timeout", "30s", "30000", nil) {
+		t.Error("different values are not expected to be suppressed")
+	}
+}
+
+func Test_applyDatabaseSettings(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	meta := newTestSQLMeta(t)
+
+	execTestSQL(t, meta, `CREATE DATABASE database_settings_test`)
+	t.Cleanup(func() {
+		execTestSQL(t, meta, `DROP DATABASE database_settings_test`)
+	})
+
+	definition := resourceDatabase().TestResourceData()
+	_ = definition.Set("project_id", "myproject")
+	_ = definition.Set("branch_id", "br-foo")
+	_ = definition.Set("name", "database_settings_test")
+
+	settings := map[string]interface{}{
+		"statement_timeout": "30s",
+		"search_path":       "$user, public",
+		"timezone":          "UTC",
+	}
+	if err := applyDatabaseSettings(context.TODO(), definition, meta, nil, settings); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := definition.Get("settings").(map[string]interface{}); !reflect.DeepEqual(got, settings) {
+		t.Errorf("unexpected settings: want=%v, got=%v", settings, got)
+	}
+
+	// the drift shall be detected
+	execTestSQL(t, meta, `ALTER DATABASE database_settings_test SET work_mem TO '8MB'`)
+	if err := withSQLConn(context.TODO(), meta, sqlTarget{}, func(conn *pgx.Conn) error {
+		v, err := readDatabaseSettings(context.TODO(), conn, "database_settings_test")
+		if err != nil {
+			return err
+		}
+		if v["work_mem"] != "8MB" {
+			t.Errorf("unexpected settings: %v", v)
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
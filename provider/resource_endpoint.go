@@ -20,7 +20,11 @@ const (
 
 func resourceEndpoint() *schema.Resource {
 	return &schema.Resource{
-		Description:   `Project Endpoint. See details: https://neon.tech/docs/manage/endpoints/`,
+		Description: `Project Endpoint. See details: https://neon.tech/docs/manage/endpoints/
+
+The autoscaling limits are verified against the plan limits reported by the Neon API when planning.
+The limits are not verified for the organization's project because the Neon API does not report
+the organization's plan limits.`,
 		SchemaVersion: 8,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceEndpointImport,
@@ -29,6 +33,7 @@ func resourceEndpoint() *schema.Resource {
 		ReadContext:   resourceEndpointReadRetry,
 		UpdateContext: resourceEndpointUpdateRetry,
 		DeleteContext: resourceEndpointDeleteRetry,
+		CustomizeDiff: resourceEndpointCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
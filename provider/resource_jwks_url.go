@@ -3,6 +3,8 @@ package provider
 import (
 	"context"
 	"errors"
+	"net/http"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -14,13 +16,18 @@ func resourceJwksUrl() *schema.Resource {
 	return &schema.Resource{
 		Description: `Project JWKS URL. See details: https://neon.com/docs/data-api/custom-authentication-providers
 
-~>**WARNING** The resource does not support import.
+The Neon API does not support modification of the JWKS, hence the change of the attributes ` + "`provider_name`" + `,
+` + "`role_names`" + ` and ` + "`jwt_audience`" + ` registers the new JWKS before the old one is deleted
+to avoid interruption of the authentication.
+If the Neon API rejects the new JWKS as duplicate of the old one, the old JWKS is deleted first,
+and it is registered back if the new JWKS cannot be registered.
 `,
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceJwksUrlImport,
 		},
 		CreateContext: resourceJwksUrlCreateRetry,
 		ReadContext:   resourceJwksUrlReadRetry,
+		UpdateContext: resourceJwksUrlUpdate,
 		DeleteContext: resourceJwksUrlDeleteRetry,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -38,7 +45,6 @@ func resourceJwksUrl() *schema.Resource {
 			"provider_name": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "The name of the authentication provider.",
 			},
 			"role_names": {
@@ -46,7 +52,6 @@ func resourceJwksUrl() *schema.Resource {
 				MinItems:    1,
 				MaxItems:    10,
 				Required:    true,
-				ForceNew:    true,
 				Description: "The roles the JWKS should be mapped to.",
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
@@ -58,7 +63,6 @@ func resourceJwksUrl() *schema.Resource {
 			},
 			"jwt_audience": {
 				Type:        schema.TypeString,
-				ForceNew:    true,
 				Optional:    true,
 				Description: "The name of the required JWT Audience to be used.",
 			},
@@ -81,21 +85,21 @@ func updateStateJwksUrl(d *schema.ResourceData, v neon.JWKS, roleNames *[]string
 			return err
 		}
 	}
+	var branchID string
 	if v.BranchID != nil {
-		if err := d.Set("branch_id", *v.BranchID); err != nil {
-			return err
-		}
+		branchID = *v.BranchID
+	}
+	if err := d.Set("branch_id", branchID); err != nil {
+		return err
 	}
+	var jwtAudience string
 	if v.JwtAudience != nil {
-		if err := d.Set("jwt_audience", *v.JwtAudience); err != nil {
-			return err
-		}
+		jwtAudience = *v.JwtAudience
 	}
-	return nil
+	return d.Set("jwt_audience", jwtAudience)
 }
 
-func resourceJwksUrlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
-	tflog.Trace(ctx, "create JWKS URL")
+func newAddProjectJWKSRequest(d *schema.ResourceData) neon.AddProjectJWKSRequest {
 	cfg := neon.AddProjectJWKSRequest{
 		JwksURL:      d.Get("jwks_url").(string),
 		ProviderName: d.Get("provider_name").(string),
@@ -116,10 +120,16 @@ func resourceJwksUrlCreate(ctx context.Context, d *schema.ResourceData, meta int
 	if v, ok := d.GetOk("jwt_audience"); ok && v.(string) != "" {
 		cfg.JwtAudience = pointer(v.(string))
 	}
+	return cfg
+}
+
+func resourceJwksUrlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "create JWKS URL")
+	cfg := newAddProjectJWKSRequest(d)
 
 	tflog.Debug(ctx, "create JWKS URL", map[string]interface{}{"cfg": cfg})
 
-	client := meta.(*neon.Client)
+	client := meta.(sdkJwks)
 	resp, err := client.AddProjectJWKS(d.Get("project_id").(string), cfg)
 	if err == nil {
 		waitUnfinishedOperations(ctx, client, resp.OperationsResponse.Operations)
@@ -142,7 +152,7 @@ func resourceJwksUrlRead(ctx context.Context, d *schema.ResourceData, meta inter
 
 	var resp neon.ProjectJWKSResponse
 	if err == nil {
-		resp, err = meta.(*neon.Client).GetProjectJWKS(projectID)
+		resp, err = meta.(sdkJwks).GetProjectJWKS(projectID)
 	}
 	if err == nil {
 		var jwks neon.JWKS
@@ -159,17 +169,124 @@ func resourceJwksUrlRead(ctx context.Context, d *schema.ResourceData, meta inter
 			})
 			d.SetId("")
 		} else {
-			err = updateStateJwksUrl(d, jwks, nil)
+			err = updateStateJwksUrl(d, jwks, jwks.RoleNames)
 		}
 	}
 	tflog.Trace(ctx, "successfully read JWKS URL", map[string]interface{}{"id": d.Id()})
 	return err
 }
 
+// deleteJwksRetry deletes the JWKS jwksID, the JWKS which does not exist is considered deleted.
+func deleteJwksRetry(ctx context.Context, d *schema.ResourceData, meta interface{}, jwksID string) diag.Diagnostics {
+	return projectReadiness.RetryWithFallback(
+		func(_ context.Context, d *schema.ResourceData, meta interface{}) error {
+			_, err := meta.(sdkJwks).DeleteProjectJWKS(d.Get("project_id").(string), jwksID)
+			return err
+		},
+		ctx, d, meta, map[int]FallbackFn{
+			http.StatusNotFound: func(_ context.Context, _ *schema.ResourceData, _ interface{}) error {
+				return nil
+			},
+		},
+	)
+}
+
+// isDuplicateJwksError defines if the API rejected the registration of the JWKS because its URL is registered.
+func isDuplicateJwksError(err error) bool {
+	var e neon.Error
+	if !errors.As(err, &e) {
+		return false
+	}
+	switch e.HTTPCode {
+	case http.StatusConflict:
+		return true
+	case http.StatusBadRequest:
+		msg := strings.ToLower(e.Message)
+		return strings.Contains(msg, "jwks") && (strings.Contains(msg, "already") || strings.Contains(msg, "duplicate"))
+	default:
+		return false
+	}
+}
+
+// resourceJwksUrlUpdate registers the JWKS with the new attributes first, and deletes the old JWKS afterwards
+// because the Neon API does not support modification of the JWKS.
+// If the old JWKS cannot be deleted, the new JWKS is deleted to restore the original registration.
+// If the API rejects the new JWKS as duplicate of the old one, the old JWKS is deleted before the new one
+// is registered, and it is registered back if the new one cannot be registered.
+func resourceJwksUrlUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	oldID := d.Id()
+	tflog.Trace(ctx, "update JWKS URL", map[string]interface{}{"id": oldID})
+
+	var duplicate bool
+	if diags := projectReadiness.Retry(func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+		err := resourceJwksUrlCreate(ctx, d, meta)
+		if isDuplicateJwksError(err) {
+			duplicate = true
+			return nil
+		}
+		return err
+	}, ctx, d, meta); diags.HasError() {
+		d.SetId(oldID)
+		return diags
+	}
+	if duplicate {
+		return replaceJwksUrl(ctx, d, meta)
+	}
+
+	newID := d.Id()
+	tflog.Debug(ctx, "delete replaced JWKS URL", map[string]interface{}{"id": oldID, "new_id": newID})
+	diags := deleteJwksRetry(ctx, d, meta, oldID)
+	if !diags.HasError() {
+		return nil
+	}
+
+	tflog.Debug(ctx, "delete new JWKS URL", map[string]interface{}{"id": newID})
+	if rollback := deleteJwksRetry(ctx, d, meta, newID); rollback.HasError() {
+		return diag.Errorf("failed to delete the replaced JWKS %s, delete it manually: %s; "+
+			"the new JWKS %s cannot be deleted: %s", oldID, diags[0].Summary, newID, rollback[0].Summary)
+	}
+
+	d.SetId(oldID)
+	if err := resourceJwksUrlRead(ctx, d, meta); err != nil {
+		tflog.Debug(ctx, "JWKS URL not read", map[string]interface{}{"id": oldID, "error": err.Error()})
+	}
+	return diag.Errorf("failed to delete the replaced JWKS %s, the new JWKS %s was deleted: %s",
+		oldID, newID, diags[0].Summary)
+}
+
+// replaceJwksUrl deletes the JWKS before its replacement is registered,
+// the deleted JWKS is registered back if the replacement cannot be registered.
+func replaceJwksUrl(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	oldID := d.Id()
+	tflog.Debug(ctx, "delete JWKS URL before registering the new one", map[string]interface{}{"id": oldID})
+	if diags := deleteJwksRetry(ctx, d, meta, oldID); diags.HasError() {
+		return diags
+	}
+
+	diags := projectReadiness.Retry(resourceJwksUrlCreate, ctx, d, meta)
+	if !diags.HasError() {
+		return nil
+	}
+
+	tflog.Debug(ctx, "register the deleted JWKS URL back", map[string]interface{}{"id": oldID})
+	for _, k := range []string{"provider_name", "role_names", "jwt_audience"} {
+		o, _ := d.GetChange(k)
+		if err := d.Set(k, o); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if restore := projectReadiness.Retry(resourceJwksUrlCreate, ctx, d, meta); restore.HasError() {
+		d.SetId("")
+		return diag.Errorf("failed to register the JWKS: %s; the deleted JWKS %s cannot be registered back: %s",
+			diags[0].Summary, oldID, restore[0].Summary)
+	}
+	return diag.Errorf("failed to register the JWKS, the deleted JWKS %s was registered back as %s: %s",
+		oldID, d.Id(), diags[0].Summary)
+}
+
 func resourceJwksUrlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
 	tflog.Trace(ctx, "delete JWKS URL", map[string]interface{}{"id": d.Id()})
-	client := meta.(*neon.Client)
-	resp, err := client.DeleteProjectJWKS(d.Get("project_id").(string), d.Id())
+	resp, err := meta.(sdkJwks).DeleteProjectJWKS(d.Get("project_id").(string), d.Id())
 	if err == nil {
 		err = updateStateJwksUrl(d, resp, nil)
 	}
@@ -192,6 +309,36 @@ func resourceJwksUrlDeleteRetry(ctx context.Context, d *schema.ResourceData, met
 	return projectReadiness.Retry(resourceJwksUrlDelete, ctx, d, meta)
 }
 
-func resourceJwksUrlImport(_ context.Context, _ *schema.ResourceData, _ interface{}) ([]*schema.ResourceData, error) {
-	return nil, errors.New("the resource does not support import, please recreate it instead")
+func resourceJwksUrlImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import JWKS URL")
+
+	els := strings.SplitN(d.Id(), "/", 2)
+	if len(els) != 2 {
+		return nil, errors.New("invalid identifier, expected {{.ProjectID}}/{{.JwksID}}")
+	}
+	if err := d.Set("project_id", els[0]); err != nil {
+		return nil, err
+	}
+	d.SetId(els[1])
+
+	if diags := projectReadiness.Retry(resourceJwksUrlRead, ctx, d, meta); diags.HasError() {
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		_ = d.Set("project_id", "")
+		return nil, errors.New("no JWKS found")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type sdkJwks interface {
+	AddProjectJWKS(projectID string, cfg neon.AddProjectJWKSRequest) (neon.JWKSCreationOperation, error)
+	GetProjectJWKS(projectID string) (neon.ProjectJWKSResponse, error)
+	DeleteProjectJWKS(projectID string, jwksID string) (neon.JWKS, error)
+	opsReader
 }
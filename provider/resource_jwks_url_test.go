@@ -0,0 +1,308 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_resourceJwksUrlUpdate(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	const jwksURL = "https://api.stack-auth.com/api/v1/projects/foo/.well-known/jwks.json"
+
+	t.Run("shall register the new JWKS before deleting the old one", func(t *testing.T) {
+		definition := resourceJwksUrl().TestResourceData()
+		definition.SetId("old")
+		_ = definition.Set("project_id", "myproject")
+		_ = definition.Set("jwks_url", jwksURL)
+		_ = definition.Set("provider_name", "Stack")
+		_ = definition.Set("role_names", []string{"foo", "bar"})
+		_ = definition.Set("jwt_audience", "qux")
+
+		meta := &sdkClientStub{
+			stubJwks: stubJwks{
+				Jwks: []neon.JWKS{
+					{ID: "old", ProjectID: "myproject", JwksURL: jwksURL, RoleNames: &[]string{"foo"}},
+				},
+			},
+		}
+
+		if diags := resourceJwksUrlUpdate(context.TODO(), definition, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if len(meta.Jwks) != 1 {
+			t.Fatalf("unexpected number of JWKS: %d", len(meta.Jwks))
+		}
+		if definition.Id() == "old" || definition.Id() != meta.Jwks[0].ID {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+		if want := []string{"foo", "bar"}; !reflect.DeepEqual(*meta.Jwks[0].RoleNames, want) {
+			t.Errorf("unexpected role names: want=%v, got=%v", want, *meta.Jwks[0].RoleNames)
+		}
+		if v := *meta.Jwks[0].JwtAudience; v != "qux" {
+			t.Errorf("unexpected jwt_audience: %s", v)
+		}
+	})
+
+	newDefinition := func() *schema.ResourceData {
+		definition := resourceJwksUrl().TestResourceData()
+		definition.SetId("old")
+		_ = definition.Set("project_id", "myproject")
+		_ = definition.Set("jwks_url", jwksURL)
+		_ = definition.Set("provider_name", "Stack")
+		_ = definition.Set("role_names", []string{"foo", "bar"})
+		return definition
+	}
+	oldJwks := neon.JWKS{ID: "old", ProjectID: "myproject", JwksURL: jwksURL, ProviderName: "Stack",
+		RoleNames: &[]string{"foo"}}
+
+	t.Run("shall delete the old JWKS first if the API rejects the duplicate", func(t *testing.T) {
+		definition := newDefinition()
+		meta := &sdkClientStub{
+			stubJwks: stubJwks{Jwks: []neon.JWKS{oldJwks}, rejectDuplicateJwksURL: true},
+		}
+
+		if diags := resourceJwksUrlUpdate(context.TODO(), definition, meta); diags.HasError() {
+			t.Fatalf("unexpected error: %v", diags)
+		}
+
+		if len(meta.Jwks) != 1 {
+			t.Fatalf("unexpected number of JWKS: %d", len(meta.Jwks))
+		}
+		if definition.Id() == "old" || definition.Id() != meta.Jwks[0].ID {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+	})
+
+	t.Run("shall keep the old JWKS if the API rejects the new one not as duplicate", func(t *testing.T) {
+		definition := newDefinition()
+		var deleted bool
+		meta := &sdkClientStub{
+			stubJwks: stubJwks{
+				Jwks: []neon.JWKS{oldJwks},
+				errAdd: func(neon.AddProjectJWKSRequest) error {
+					e := neon.Error{HTTPCode: http.StatusBadRequest}
+					e.Message = "role qux does not exist"
+					return e
+				},
+				errDelete: func(string) error {
+					deleted = true
+					return nil
+				},
+			},
+		}
+
+		if diags := resourceJwksUrlUpdate(context.TODO(), definition, meta); !diags.HasError() {
+			t.Fatal("error expected")
+		}
+		if deleted {
+			t.Error("no JWKS shall be deleted")
+		}
+		if len(meta.Jwks) != 1 || definition.Id() != "old" {
+			t.Errorf("old JWKS shall be kept, ID: %s, JWKS: %v", definition.Id(), meta.Jwks)
+		}
+	})
+
+	t.Run("shall register the deleted duplicate back if the new one cannot be registered", func(t *testing.T) {
+		definition := resourceJwksUrl().Data(&terraform.InstanceState{
+			ID: "old",
+			Attributes: map[string]string{
+				"id":            "old",
+				"project_id":    "myproject",
+				"jwks_url":      jwksURL,
+				"provider_name": "Old",
+				"role_names.#":  "1",
+				"role_names.0":  "foo",
+			},
+		})
+		_ = definition.Set("provider_name", "Stack")
+		_ = definition.Set("role_names", []string{"foo", "bar"})
+
+		var calls int
+		meta := &sdkClientStub{
+			stubJwks: stubJwks{
+				Jwks: []neon.JWKS{oldJwks},
+				errAdd: func(neon.AddProjectJWKSRequest) error {
+					calls++
+					e := neon.Error{HTTPCode: http.StatusBadRequest}
+					switch calls {
+					case 1:
+						e.Message = "JWKS with this URL already exists"
+					case 2:
+						e.Message = "role bar does not exist"
+					default:
+						return nil
+					}
+					return e
+				},
+			},
+		}
+
+		diags := resourceJwksUrlUpdate(context.TODO(), definition, meta)
+		if !diags.HasError() {
+			t.Fatal("error expected")
+		}
+		if len(meta.Jwks) != 1 || meta.Jwks[0].ID == "old" {
+			t.Fatalf("deleted JWKS shall be registered back: %v", meta.Jwks)
+		}
+		if definition.Id() != meta.Jwks[0].ID {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+		if v := meta.Jwks[0].ProviderName; v != "Old" {
+			t.Errorf("JWKS shall be registered back with the old attributes, got provider_name: %s", v)
+		}
+		if !strings.Contains(diags[0].Summary, "registered back") {
+			t.Errorf("unexpected error: %s", diags[0].Summary)
+		}
+	})
+
+	t.Run("shall delete the new JWKS if the old one cannot be deleted", func(t *testing.T) {
+		definition := newDefinition()
+		meta := &sdkClientStub{
+			stubJwks: stubJwks{
+				Jwks: []neon.JWKS{oldJwks},
+				errDelete: func(jwksID string) error {
+					if jwksID == "old" {
+						return errors.New("foobar")
+					}
+					return nil
+				},
+			},
+		}
+
+		diags := resourceJwksUrlUpdate(context.TODO(), definition, meta)
+		if !diags.HasError() {
+			t.Fatal("error expected")
+		}
+		if !strings.Contains(diags[0].Summary, "old") {
+			t.Errorf("error shall name the old JWKS: %s", diags[0].Summary)
+		}
+		if len(meta.Jwks) != 1 || meta.Jwks[0].ID != "old" {
+			t.Fatalf("only the old JWKS shall be kept: %v", meta.Jwks)
+		}
+		if definition.Id() != "old" {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+	})
+
+	t.Run("shall name the orphaned JWKS if neither JWKS can be deleted", func(t *testing.T) {
+		definition := newDefinition()
+		meta := &sdkClientStub{
+			stubJwks: stubJwks{
+				Jwks:      []neon.JWKS{oldJwks},
+				errDelete: func(string) error { return errors.New("foobar") },
+			},
+		}
+
+		diags := resourceJwksUrlUpdate(context.TODO(), definition, meta)
+		if !diags.HasError() {
+			t.Fatal("error expected")
+		}
+		if !strings.Contains(diags[0].Summary, "replaced JWKS old, delete it manually") {
+			t.Errorf("error shall name the orphaned JWKS: %s", diags[0].Summary)
+		}
+		if len(meta.Jwks) != 2 {
+			t.Fatalf("unexpected number of JWKS: %d", len(meta.Jwks))
+		}
+		if definition.Id() != meta.Jwks[1].ID {
+			t.Errorf("new JWKS shall be kept in the state, got ID: %s", definition.Id())
+		}
+	})
+
+	t.Run("shall keep the old JWKS if the new one cannot be registered", func(t *testing.T) {
+		definition := resourceJwksUrl().TestResourceData()
+		definition.SetId("old")
+		_ = definition.Set("project_id", "myproject")
+		_ = definition.Set("jwks_url", jwksURL)
+		_ = definition.Set("provider_name", "Stack")
+		_ = definition.Set("role_names", []string{"foo"})
+
+		meta := &sdkClientStub{
+			stubJwks: stubJwks{err: errors.New("foobar")},
+		}
+
+		if diags := resourceJwksUrlUpdate(context.TODO(), definition, meta); !diags.HasError() {
+			t.Fatal("error expected")
+		}
+		if definition.Id() != "old" {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+	})
+}
+
+func Test_resourceJwksUrlImport(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	meta := &sdkClientStub{
+		stubJwks: stubJwks{
+			Jwks: []neon.JWKS{
+				{
+					ID:           "foo",
+					ProjectID:    "myproject",
+					JwksURL:      "https://bar.baz/.well-known/jwks.json",
+					ProviderName: "Stack",
+					RoleNames:    &[]string{"qux"},
+				},
+			},
+		},
+	}
+
+	t.Run("shall import the JWKS with its role names", func(t *testing.T) {
+		definition := resourceJwksUrl().TestResourceData()
+		definition.SetId("myproject/foo")
+
+		if _, err := resourceJwksUrlImport(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if definition.Id() != "foo" {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+		if v := definition.Get("project_id").(string); v != "myproject" {
+			t.Errorf("unexpected project_id: %s", v)
+		}
+		if v := definition.Get("role_names").([]interface{}); len(v) != 1 || v[0].(string) != "qux" {
+			t.Errorf("unexpected role_names: %v", v)
+		}
+	})
+
+	t.Run("unhappy path: JWKS not found", func(t *testing.T) {
+		definition := resourceJwksUrl().TestResourceData()
+		definition.SetId("myproject/missing")
+
+		if _, err := resourceJwksUrlImport(context.TODO(), definition, meta); err == nil {
+			t.Fatal("error expected")
+		}
+	})
+
+	t.Run("unhappy path: invalid ID", func(t *testing.T) {
+		definition := resourceJwksUrl().TestResourceData()
+		definition.SetId("foo")
+
+		if _, err := resourceJwksUrlImport(context.TODO(), definition, meta); err == nil {
+			t.Fatal("error expected")
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceOperationsWait() *schema.Resource {
+	return &schema.Resource{
+		Description: `Waits for completion of the unfinished operations of the project, or of the branch.
+
+The resource blocks the apply until all running operations finish, or the timeout is reached.
+Use the attribute ` + "`triggers`" + ` to wait again when the referenced values change.`,
+		SchemaVersion: 1,
+		CreateContext: resourceOperationsWaitCreate,
+		ReadContext:   resourceOperationsWaitRead,
+		DeleteContext: resourceOperationsWaitDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Project ID.",
+			},
+			"branch_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Branch ID. The operations of all branches are awaited if not set.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values which trigger the wait when changed.",
+			},
+		},
+	}
+}
+
+func resourceOperationsWaitCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	projectID := d.Get("project_id").(string)
+	branchID := d.Get("branch_id").(string)
+	tflog.Trace(ctx, "wait for Operations", map[string]interface{}{"projectID": projectID, "branchID": branchID})
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	if err := waitProjectOperations(ctx, meta.(sdkOperations), projectID, branchID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	id := projectID
+	if branchID != "" {
+		id += "/" + branchID
+	}
+	d.SetId(id)
+
+	return nil
+}
+
+func resourceOperationsWaitRead(_ context.Context, _ *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	return nil
+}
+
+func resourceOperationsWaitDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+const operationsPollInterval = time.Second
+
+// waitProjectOperations polls the operations of the project until the ones of the branch branchID,
+// or of all branches if branchID is empty, finish.
+// Only the latest operations are checked, see operationsPageLimit.
+func waitProjectOperations(ctx context.Context, c sdkOperations, projectID, branchID string) error {
+	for {
+		resp, err := c.ListProjectOperations(projectID, nil, pointer(operationsPageLimit))
+		if err != nil {
+			return err
+		}
+
+		filter := operationsFilter{BranchID: branchID}
+		var unfinished int
+		for _, op := range resp.Operations {
+			if filter.match(op) && unfinishedOperation(op) {
+				unfinished++
+			}
+		}
+		if unfinished == 0 {
+			return nil
+		}
+
+		tflog.Debug(ctx, "wait for unfinished operations", map[string]interface{}{
+			"projectID":  projectID,
+			"branchID":   branchID,
+			"unfinished": unfinished,
+		})
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%d operations of the project %s are unfinished: %w", unfinished, projectID, ctx.Err())
+		case <-time.After(operationsPollInterval):
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func resourceOrganizationInvitation() *schema.Resource {
+	return &schema.Resource{
+		Description: `Invitation to join the organization. See details: https://neon.com/docs/manage/orgs-manage#invite-members
+
+The invited user receives an email notification. The user who already has the Neon account joins the organization automatically.
+
+~>**WARNING** The invitation cannot be revoked via the Neon API, the resource's deletion only removes it from the state.
+Use the resource ` + "`neon_organization_member`" + ` to remove the user who accepted the invitation from the organization.
+`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOrganizationInvitationImport,
+		},
+		CreateContext: resourceOrganizationInvitationCreateRetry,
+		ReadContext:   resourceOrganizationInvitationReadRetry,
+		DeleteContext: resourceOrganizationInvitationDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Invitation ID.",
+			},
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The organisation ID.",
+			},
+			"email": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEmailCaseDiff,
+				Description:      "Email of the user to invite. The email is case-insensitive.",
+			},
+			"role": newSchemaOrganizationMemberRole(true),
+			"invited_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the invitation was created.",
+			},
+		},
+	}
+}
+
+func updateStateOrganizationInvitation(d *schema.ResourceData, v neon.Invitation) error {
+	if err := setStateEmail(d, v.Email); err != nil {
+		return err
+	}
+	if err := d.Set("role", string(v.Role)); err != nil {
+		return err
+	}
+	return d.Set("invited_at", v.InvitedAt.Format(time.RFC3339))
+}
+
+func resourceOrganizationInvitationCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceOrganizationInvitationCreate, ctx, d, meta)
+}
+
+func resourceOrganizationInvitationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	orgID := d.Get("org_id").(string)
+	email := d.Get("email").(string)
+	tflog.Trace(ctx, "create Organization Invitation", map[string]interface{}{"orgID": orgID, "email": email})
+
+	resp, err := meta.(sdkOrganization).CreateOrganizationInvitations(orgID, neon.OrganizationInvitesCreateRequest{
+		Invitations: []neon.OrganizationInviteCreateRequest{
+			{
+				Email: email,
+				Role:  neon.MemberRole(d.Get("role").(string)),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, v := range resp.Invitations {
+		if strings.EqualFold(v.Email, email) {
+			d.SetId(v.ID)
+			return updateStateOrganizationInvitation(d, v)
+		}
+	}
+	return errors.New("no invitation created for " + email)
+}
+
+func resourceOrganizationInvitationReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceOrganizationInvitationRead, ctx, d, meta)
+}
+
+func resourceOrganizationInvitationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	orgID := d.Get("org_id").(string)
+	tflog.Trace(ctx, "read Organization Invitation", map[string]interface{}{"id": d.Id(), "orgID": orgID})
+
+	client := meta.(sdkOrganization)
+	resp, err := client.GetOrganizationInvitations(orgID)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range resp.Invitations {
+		if v.ID == d.Id() {
+			return updateStateOrganizationInvitation(d, v)
+		}
+	}
+
+	// the accepted invitation is no longer listed, it shall be kept in the state
+	// to prevent inviting the user who has already joined the organization again
+	members, err := client.GetOrganizationMembers(orgID)
+	if err != nil {
+		return err
+	}
+	if _, ok := findOrganizationMemberByEmail(members.Members, d.Get("email").(string)); ok {
+		tflog.Debug(ctx, "organization invitation was accepted", map[string]interface{}{"id": d.Id()})
+		return nil
+	}
+
+	tflog.Debug(ctx, "organization invitation not found, removing from state",
+		map[string]interface{}{"id": d.Id(), "org_id": orgID})
+	d.SetId("")
+	return nil
+}
+
+func resourceOrganizationInvitationDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "organization invitation cannot be revoked, removing from state",
+		map[string]interface{}{"id": d.Id(), "org_id": d.Get("org_id")})
+	d.SetId("")
+	return nil
+}
+
+func resourceOrganizationInvitationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import Organization Invitation")
+
+	els := strings.SplitN(d.Id(), "/", 2)
+	if len(els) != 2 {
+		return nil, fmt.Errorf("invalid identifier, expected {{.OrgID}}/{{.InvitationID}}")
+	}
+	if err := d.Set("org_id", els[0]); err != nil {
+		return nil, err
+	}
+	d.SetId(els[1])
+
+	resp, err := meta.(sdkOrganization).GetOrganizationInvitations(els[0])
+	if err != nil {
+		d.SetId("")
+		return nil, err
+	}
+
+	for _, v := range resp.Invitations {
+		if v.ID == d.Id() {
+			if err := updateStateOrganizationInvitation(d, v); err != nil {
+				return nil, err
+			}
+			return []*schema.ResourceData{d}, nil
+		}
+	}
+
+	d.SetId("")
+	_ = d.Set("org_id", "")
+	return nil, errors.New("no pending organization invitation found")
+}
@@ -0,0 +1,268 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func resourceOrganizationMember() *schema.Resource {
+	return &schema.Resource{
+		Description: `Organization Member. See details: https://neon.com/docs/manage/orgs-manage
+
+The resource manages the role of the user who has already joined the organization,
+use the resource ` + "`neon_organization_invitation`" + ` to invite the user.
+The user is removed from the organization upon the resource's deletion.
+`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceOrganizationMemberImport,
+		},
+		CreateContext: resourceOrganizationMemberCreateRetry,
+		ReadContext:   resourceOrganizationMemberReadRetry,
+		UpdateContext: resourceOrganizationMemberUpdateRetry,
+		DeleteContext: resourceOrganizationMemberDeleteRetry,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Member ID.",
+			},
+			"org_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The organisation ID.",
+			},
+			"email": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				DiffSuppressFunc: suppressEmailCaseDiff,
+				Description:      "Email of the member. The email is case-insensitive.",
+			},
+			"role": newSchemaOrganizationMemberRole(false),
+			"user_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "User ID.",
+			},
+			"joined_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Timestamp when the user joined the organization.",
+			},
+		},
+	}
+}
+
+func newSchemaOrganizationMemberRole(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    forceNew,
+		Description: `Member's role in the organization. Allowed values: "admin", "member".`,
+		ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+			switch v := neon.MemberRole(i.(string)); v {
+			case neon.MemberRoleAdmin, neon.MemberRoleMember:
+			default:
+				errs = append(errs, errors.New(string(v)+" is not supported value for "+s))
+			}
+			return
+		},
+	}
+}
+
+// suppressEmailCaseDiff suppresses the diff of the emails which differ only by case,
+// because the Neon API matches the emails case-insensitively.
+func suppressEmailCaseDiff(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	return strings.EqualFold(oldValue, newValue)
+}
+
+// setStateEmail keeps the email defined in the state if it differs from the API's value only by case.
+func setStateEmail(d *schema.ResourceData, v string) error {
+	if strings.EqualFold(d.Get("email").(string), v) {
+		return nil
+	}
+	return d.Set("email", v)
+}
+
+func updateStateOrganizationMember(d *schema.ResourceData, v neon.MemberWithUser) error {
+	if v.Member.OrgID != "" {
+		if err := d.Set("org_id", v.Member.OrgID); err != nil {
+			return err
+		}
+	}
+	if err := setStateEmail(d, v.User.Email); err != nil {
+		return err
+	}
+	if err := d.Set("role", string(v.Member.Role)); err != nil {
+		return err
+	}
+	if err := d.Set("user_id", v.Member.UserID); err != nil {
+		return err
+	}
+	var joinedAt string
+	if v.Member.JoinedAt != nil {
+		joinedAt = v.Member.JoinedAt.Format(time.RFC3339)
+	}
+	return d.Set("joined_at", joinedAt)
+}
+
+func resourceOrganizationMemberCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceOrganizationMemberCreate, ctx, d, meta)
+}
+
+func resourceOrganizationMemberCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	orgID := d.Get("org_id").(string)
+	email := d.Get("email").(string)
+	tflog.Trace(ctx, "create Organization Member", map[string]interface{}{"orgID": orgID, "email": email})
+
+	client := meta.(sdkOrganization)
+	resp, err := client.GetOrganizationMembers(orgID)
+	if err != nil {
+		return err
+	}
+
+	member, ok := findOrganizationMemberByEmail(resp.Members, email)
+	if !ok {
+		return fmt.Errorf("user %s is not a member of the organization %s, invite the user first", email, orgID)
+	}
+
+	role := neon.MemberRole(d.Get("role").(string))
+	if member.Member.Role != role {
+		member.Member, err = client.UpdateOrganizationMember(orgID, member.Member.ID,
+			neon.OrganizationMemberUpdateRequest{Role: role},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(member.Member.ID)
+	return updateStateOrganizationMember(d, member)
+}
+
+func resourceOrganizationMemberReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.RetryWithFallback(resourceOrganizationMemberRead, ctx, d, meta, map[int]FallbackFn{
+		http.StatusNotFound: func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			tflog.Debug(ctx, "organization member not found, removing from state",
+				map[string]interface{}{"id": d.Id(), "org_id": d.Get("org_id")})
+			d.SetId("")
+			return nil
+		}})
+}
+
+func resourceOrganizationMemberRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "read Organization Member", map[string]interface{}{"id": d.Id()})
+
+	resp, err := meta.(sdkOrganization).GetOrganizationMembers(d.Get("org_id").(string))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range resp.Members {
+		if v.Member.ID == d.Id() {
+			return updateStateOrganizationMember(d, v)
+		}
+	}
+
+	tflog.Debug(ctx, "organization member not found, removing from state",
+		map[string]interface{}{"id": d.Id(), "org_id": d.Get("org_id")})
+	d.SetId("")
+	return nil
+}
+
+func resourceOrganizationMemberUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceOrganizationMemberUpdate, ctx, d, meta)
+}
+
+func resourceOrganizationMemberUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "update Organization Member", map[string]interface{}{"id": d.Id()})
+
+	if !d.HasChange("role") {
+		return nil
+	}
+
+	resp, err := meta.(sdkOrganization).UpdateOrganizationMember(d.Get("org_id").(string), d.Id(),
+		neon.OrganizationMemberUpdateRequest{Role: neon.MemberRole(d.Get("role").(string))},
+	)
+	if err != nil {
+		return err
+	}
+	return d.Set("role", string(resp.Role))
+}
+
+func resourceOrganizationMemberDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.RetryWithFallback(resourceOrganizationMemberDelete, ctx, d, meta, map[int]FallbackFn{
+		http.StatusNotFound: func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			d.SetId("")
+			return nil
+		},
+	})
+}
+
+func resourceOrganizationMemberDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "remove Organization Member", map[string]interface{}{"id": d.Id()})
+
+	if _, err := meta.(sdkOrganization).RemoveOrganizationMember(d.Get("org_id").(string), d.Id()); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourceOrganizationMemberImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import Organization Member")
+
+	els := strings.SplitN(d.Id(), "/", 2)
+	if len(els) != 2 {
+		return nil, fmt.Errorf("invalid identifier, expected {{.OrgID}}/{{.MemberID}}")
+	}
+	if err := d.Set("org_id", els[0]); err != nil {
+		return nil, err
+	}
+	d.SetId(els[1])
+
+	if diags := projectReadiness.Retry(resourceOrganizationMemberRead, ctx, d, meta); diags.HasError() {
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		_ = d.Set("org_id", "")
+		return nil, errors.New("no organization member found")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func findOrganizationMemberByEmail(members []neon.MemberWithUser, email string) (neon.MemberWithUser, bool) {
+	for _, v := range members {
+		if strings.EqualFold(v.User.Email, email) {
+			return v, true
+		}
+	}
+	return neon.MemberWithUser{}, false
+}
+
+type sdkOrganization interface {
+	GetOrganization(orgID string) (neon.Organization, error)
+	GetOrganizationMembers(orgID string) (neon.OrganizationMembersResponse, error)
+	UpdateOrganizationMember(orgID string, memberID string, cfg neon.OrganizationMemberUpdateRequest) (neon.Member,
+		error)
+	RemoveOrganizationMember(orgID string, memberID string) (neon.EmptyResponse, error)
+	GetOrganizationInvitations(orgID string) (neon.OrganizationInvitationsResponse, error)
+	CreateOrganizationInvitations(orgID string, cfg neon.OrganizationInvitesCreateRequest) (
+		neon.OrganizationInvitationsResponse, error)
+}
@@ -0,0 +1,153 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_resourceOrganizationMemberCreate(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	const orgID = "org-foo"
+
+	t.Run("shall update the role of the existing member", func(t *testing.T) {
+		definition := resourceOrganizationMember().TestResourceData()
+		_ = definition.Set("org_id", orgID)
+		_ = definition.Set("email", "Foo@bar.baz")
+		_ = definition.Set("role", "admin")
+
+		meta := &sdkClientStub{
+			stubOrganization: stubOrganization{
+				Members: []neon.MemberWithUser{
+					{
+						Member: neon.Member{ID: "bar", OrgID: orgID, Role: neon.MemberRoleMember, UserID: "qux"},
+						User:   neon.MemberUserInfo{Email: "bar@bar.baz"},
+					},
+					{
+						Member: neon.Member{ID: "foo", OrgID: orgID, Role: neon.MemberRoleMember, UserID: "quux"},
+						User:   neon.MemberUserInfo{Email: "foo@bar.baz"},
+					},
+				},
+			},
+		}
+
+		if err := resourceOrganizationMemberCreate(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if definition.Id() != "foo" {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+		if v := definition.Get("user_id").(string); v != "quux" {
+			t.Errorf("unexpected user_id: %s", v)
+		}
+		if v := definition.Get("email").(string); v != "Foo@bar.baz" {
+			t.Errorf("configured email shall be kept if it differs only by case, got: %s", v)
+		}
+		if meta.Members[1].Member.Role != neon.MemberRoleAdmin {
+			t.Errorf("unexpected member role: %s", meta.Members[1].Member.Role)
+		}
+		if meta.Members[0].Member.Role != neon.MemberRoleMember {
+			t.Error("other members' roles shall not be changed")
+		}
+	})
+
+	t.Run("unhappy path: the user is not a member", func(t *testing.T) {
+		definition := resourceOrganizationMember().TestResourceData()
+		_ = definition.Set("org_id", orgID)
+		_ = definition.Set("email", "foo@bar.baz")
+		_ = definition.Set("role", "member")
+
+		meta := &sdkClientStub{}
+
+		if err := resourceOrganizationMemberCreate(context.TODO(), definition, meta); err == nil {
+			t.Fatal("error expected")
+		}
+		if definition.Id() != "" {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+	})
+
+	t.Run("unhappy path: API error", func(t *testing.T) {
+		definition := resourceOrganizationMember().TestResourceData()
+		_ = definition.Set("org_id", orgID)
+		_ = definition.Set("email", "foo@bar.baz")
+		_ = definition.Set("role", "member")
+
+		meta := &sdkClientStub{
+			stubOrganization: stubOrganization{err: errors.New("foobar")},
+		}
+
+		if err := resourceOrganizationMemberCreate(context.TODO(), definition, meta); err == nil {
+			t.Fatal("error expected")
+		}
+	})
+}
+
+func Test_resourceOrganizationInvitationRead(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	const orgID = "org-foo"
+
+	t.Run("shall keep the accepted invitation in the state", func(t *testing.T) {
+		definition := resourceOrganizationInvitation().TestResourceData()
+		_ = definition.Set("org_id", orgID)
+		_ = definition.Set("email", "foo@bar.baz")
+		_ = definition.Set("role", "member")
+
+		meta := &sdkClientStub{}
+		if err := resourceOrganizationInvitationCreate(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		id := definition.Id()
+		if id == "" {
+			t.Fatal("resource ID expected to be set")
+		}
+
+		// the user accepts the invitation
+		meta.Invitations = nil
+		meta.Members = []neon.MemberWithUser{
+			{
+				Member: neon.Member{ID: "foo", OrgID: orgID, Role: neon.MemberRoleMember},
+				User:   neon.MemberUserInfo{Email: "foo@bar.baz"},
+			},
+		}
+
+		if err := resourceOrganizationInvitationRead(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if definition.Id() != id {
+			t.Errorf("unexpected resource ID: want=%s, got=%s", id, definition.Id())
+		}
+	})
+
+	t.Run("shall remove the revoked invitation from the state", func(t *testing.T) {
+		definition := resourceOrganizationInvitation().TestResourceData()
+		definition.SetId("foo")
+		_ = definition.Set("org_id", orgID)
+		_ = definition.Set("email", "foo@bar.baz")
+		_ = definition.Set("role", "member")
+
+		if err := resourceOrganizationInvitationRead(context.TODO(), definition, &sdkClientStub{}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if definition.Id() != "" {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+	})
+}
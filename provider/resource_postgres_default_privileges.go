@@ -0,0 +1,338 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
+)
+
+var postgresDefaultPrivilegesObjectTypes = []string{
+	postgresObjectSchema, postgresObjectTable, postgresObjectSequence, postgresObjectFunction,
+}
+
+// postgresDefaultACLObjectTypes maps the object type to the type of the default ACL in pg_default_acl.
+var postgresDefaultACLObjectTypes = map[string]string{
+	postgresObjectSchema:   "n",
+	postgresObjectTable:    "r",
+	postgresObjectSequence: "S",
+	postgresObjectFunction: "f",
+}
+
+func resourcePostgresDefaultPrivileges() *schema.Resource {
+	return &schema.Resource{
+		Description: `Default privileges of the Postgres role on the objects to be created.
+See details: https://www.postgresql.org/docs/current/sql-alterdefaultprivileges.html
+
+The resource is authoritative for the default privileges of the role on the objects of the type created by the owner.
+`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePostgresDefaultPrivilegesImport,
+		},
+		CreateContext: resourcePostgresDefaultPrivilegesCreateRetry,
+		ReadContext:   resourcePostgresDefaultPrivilegesReadRetry,
+		UpdateContext: resourcePostgresDefaultPrivilegesUpdateRetry,
+		DeleteContext: resourcePostgresDefaultPrivilegesDeleteRetry,
+		CustomizeDiff: resourcePostgresDefaultPrivilegesCustomizeDiff,
+		Schema: newSchemaSQLTarget(map[string]*schema.Schema{
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Role to grant the privileges to. Use "public" to grant the privileges to all roles.`,
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Role which creates the objects.",
+			},
+			"object_type": newSchemaPostgresObjectType(postgresDefaultPrivilegesObjectTypes...),
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: `Schema to apply the default privileges to the objects created in.
+The default privileges apply to the objects created in any schema if not set.
+Cannot be set for the object type "schema".`,
+			},
+			"privileges": newSchemaPostgresPrivileges(postgresDefaultPrivilegesObjectTypes...),
+			"with_grant_option": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Allow the role to grant the privileges to other roles.",
+			},
+		}),
+	}
+}
+
+func validatePostgresDefaultPrivileges(v postgresDefaultPrivileges) error {
+	if v.ObjectType == postgresObjectSchema && v.Schema != "" {
+		return errors.New("schema cannot be set for the object type schema")
+	}
+	return validatePostgresPrivileges(v.ObjectType, v.Privileges)
+}
+
+func resourcePostgresDefaultPrivilegesCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	return validatePostgresDefaultPrivileges(postgresDefaultPrivileges{
+		ObjectType: d.Get("object_type").(string),
+		Schema:     d.Get("schema").(string),
+		Privileges: getStringSet(d.Get("privileges")),
+	})
+}
+
+// postgresDefaultPrivileges defines the privileges of the role on the objects to be created by the owner.
+type postgresDefaultPrivileges struct {
+	Role, Owner, ObjectType, Schema string
+	Privileges                      []string
+	WithGrantOption                 bool
+}
+
+func newPostgresDefaultPrivileges(d *schema.ResourceData) postgresDefaultPrivileges {
+	return postgresDefaultPrivileges{
+		Role:            d.Get("role").(string),
+		Owner:           d.Get("owner").(string),
+		ObjectType:      d.Get("object_type").(string),
+		Schema:          d.Get("schema").(string),
+		Privileges:      getStringSet(d.Get("privileges")),
+		WithGrantOption: d.Get("with_grant_option").(bool),
+	}
+}
+
+func (v postgresDefaultPrivileges) prefix() string {
+	s := "ALTER DEFAULT PRIVILEGES FOR ROLE " + quoteIdentifier(v.Owner)
+	if v.Schema != "" {
+		s += " IN SCHEMA " + quoteIdentifier(v.Schema)
+	}
+	return s
+}
+
+func (v postgresDefaultPrivileges) on() string {
+	return strings.ToUpper(v.ObjectType) + "S"
+}
+
+func (v postgresDefaultPrivileges) grantStatement(privileges []string) string {
+	s := v.prefix() + " GRANT " + strings.Join(privileges, ", ") + " ON " + v.on() + " TO " + quoteRole(v.Role)
+	if v.WithGrantOption {
+		s += " WITH GRANT OPTION"
+	}
+	return s
+}
+
+func (v postgresDefaultPrivileges) revokeStatement(privileges []string) string {
+	return v.prefix() + " REVOKE " + strings.Join(privileges, ", ") + " ON " + v.on() + " FROM " +
+		quoteRole(v.Role)
+}
+
+// readPostgresDefaultPrivileges returns the default privileges of the role from pg_default_acl.
+func readPostgresDefaultPrivileges(ctx context.Context, conn *pgx.Conn, v postgresDefaultPrivileges) (
+	[]string, error,
+) {
+	role := v.Role
+	if strings.EqualFold(role, "public") {
+		role = "PUBLIC"
+	}
+
+	rows, err := conn.Query(ctx, `SELECT a.privilege_type
+FROM pg_catalog.pg_default_acl d
+LEFT JOIN pg_catalog.pg_namespace n ON n.oid = d.defaclnamespace
+CROSS JOIN LATERAL aclexplode(d.defaclacl) a
+WHERE pg_catalog.pg_get_userbyid(d.defaclrole) = $1
+	AND d.defaclobjtype::text = $2
+	AND COALESCE(n.nspname::text, '') = $3
+	AND `+postgresGranteeExpression+` = $4`,
+		v.Owner, postgresDefaultACLObjectTypes[v.ObjectType], v.Schema, role,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	privileges, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, err
+	}
+	slices.Sort(privileges)
+	return privileges, nil
+}
+
+func resourcePostgresDefaultPrivilegesCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresDefaultPrivilegesCreate, ctx, d, meta)
+}
+
+func resourcePostgresDefaultPrivilegesCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	t := newSQLTarget(d)
+	v := newPostgresDefaultPrivileges(d)
+	r := postgresDefaultPrivilegesID{
+		sqlObjectID: newSQLObjectID(t, v.Role),
+		Owner:       v.Owner,
+		ObjectType:  v.ObjectType,
+		Schema:      v.Schema,
+	}
+	tflog.Trace(ctx, "create Postgres Default Privileges", map[string]interface{}{"id": r.toString()})
+
+	if err := withSQLConn(ctx, meta, t, func(conn *pgx.Conn) error {
+		current, err := readPostgresDefaultPrivileges(ctx, conn, v)
+		if err != nil {
+			return err
+		}
+		// the resource is authoritative, hence the default privileges granted beforehand are revoked
+		if revoke := difference(current, v.Privileges); len(revoke) > 0 {
+			if _, err := conn.Exec(ctx, v.revokeStatement(revoke)); err != nil {
+				return err
+			}
+		}
+		_, err = conn.Exec(ctx, v.grantStatement(v.Privileges))
+		return err
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(r.toString())
+	return resourcePostgresDefaultPrivilegesRead(ctx, d, meta)
+}
+
+func resourcePostgresDefaultPrivilegesReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLRead(resourcePostgresDefaultPrivilegesRead, ctx, d, meta)
+}
+
+func resourcePostgresDefaultPrivilegesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "read Postgres Default Privileges", map[string]interface{}{"id": d.Id()})
+
+	var privileges []string
+	if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) (err error) {
+		privileges, err = readPostgresDefaultPrivileges(ctx, conn, newPostgresDefaultPrivileges(d))
+		return err
+	}); err != nil {
+		return err
+	}
+	return d.Set("privileges", privileges)
+}
+
+func resourcePostgresDefaultPrivilegesUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresDefaultPrivilegesUpdate, ctx, d, meta)
+}
+
+func resourcePostgresDefaultPrivilegesUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "update Postgres Default Privileges", map[string]interface{}{"id": d.Id()})
+
+	if d.HasChange("privileges") {
+		v := newPostgresDefaultPrivileges(d)
+		o, n := d.GetChange("privileges")
+		oldPrivileges, newPrivileges := getStringSet(o), getStringSet(n)
+
+		if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+			if revoke := difference(oldPrivileges, newPrivileges); len(revoke) > 0 {
+				if _, err := conn.Exec(ctx, v.revokeStatement(revoke)); err != nil {
+					return err
+				}
+			}
+			if grant := difference(newPrivileges, oldPrivileges); len(grant) > 0 {
+				if _, err := conn.Exec(ctx, v.grantStatement(grant)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return resourcePostgresDefaultPrivilegesRead(ctx, d, meta)
+}
+
+func resourcePostgresDefaultPrivilegesDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLDelete(resourcePostgresDefaultPrivilegesDelete, ctx, d, meta)
+}
+
+func resourcePostgresDefaultPrivilegesDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "delete Postgres Default Privileges", map[string]interface{}{"id": d.Id()})
+
+	v := newPostgresDefaultPrivileges(d)
+	if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx, v.revokeStatement(v.Privileges))
+		return err
+	}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourcePostgresDefaultPrivilegesImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import Postgres Default Privileges")
+
+	r, err := parsePostgresDefaultPrivilegesID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	_ = d.Set("project_id", r.ProjectID)
+	_ = d.Set("branch_id", r.BranchID)
+	_ = d.Set("database", r.Database)
+	_ = d.Set("role", r.Name)
+	_ = d.Set("owner", r.Owner)
+	_ = d.Set("object_type", r.ObjectType)
+	_ = d.Set("schema", r.Schema)
+	_ = d.Set("with_grant_option", false)
+
+	if diags := projectReadiness.Retry(resourcePostgresDefaultPrivilegesRead, ctx, d, meta); diags.HasError() {
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	if len(getStringSet(d.Get("privileges"))) == 0 {
+		d.SetId("")
+		return nil, errors.New("the role has no default privileges on the objects")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// postgresDefaultPrivilegesID is the identifier of the default privileges which follows the template:
+// {{.ProjectID}}/{{.BranchID}}/{{.Database}}/{{.Role}}/{{.Owner}}/{{.ObjectType}}/{{.Schema}},
+// where the schema is empty if the default privileges apply to all schemas.
+// The name of the embedded sqlObjectID is the role.
+type postgresDefaultPrivilegesID struct {
+	sqlObjectID
+	Owner, ObjectType, Schema string
+}
+
+func (v postgresDefaultPrivilegesID) toString() string {
+	r := v.sqlObjectID
+	r.Name = strings.Join([]string{v.Name, v.Owner, v.ObjectType, v.Schema}, "/")
+	return r.toString()
+}
+
+func parsePostgresDefaultPrivilegesID(s string) (postgresDefaultPrivilegesID, error) {
+	errTemplate := errors.New("ID of this resource type shall follow the template: " +
+		"{{.ProjectID}}/{{.BranchID}}/{{.Database}}/{{.Role}}/{{.Owner}}/{{.ObjectType}}/{{.Schema}}",
+	)
+
+	r, err := parseSQLObjectID(s)
+	if err != nil {
+		return postgresDefaultPrivilegesID{}, errTemplate
+	}
+	spl := strings.SplitN(r.Name, "/", 4)
+	if len(spl) != 4 || r.ProjectID == "" || r.BranchID == "" || r.Database == "" ||
+		spl[0] == "" || spl[1] == "" || spl[2] == "" {
+		return postgresDefaultPrivilegesID{}, errTemplate
+	}
+
+	r.Name = spl[0]
+	return postgresDefaultPrivilegesID{
+		sqlObjectID: r,
+		Owner:       spl[1],
+		ObjectType:  spl[2],
+		Schema:      spl[3],
+	}, nil
+}
@@ -0,0 +1,126 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func Test_postgresDefaultPrivileges_statements(t *testing.T) {
+	v := postgresDefaultPrivileges{
+		Role: "foo", Owner: "owner", ObjectType: postgresObjectTable, Schema: "app",
+		Privileges: []string{"INSERT", "SELECT"},
+	}
+
+	const wantGrant = `ALTER DEFAULT PRIVILEGES FOR ROLE "owner" IN SCHEMA "app" GRANT INSERT, SELECT ON TABLES TO "foo"`
+	if got := v.grantStatement(v.Privileges); got != wantGrant {
+		t.Errorf("grantStatement() = %v, want %v", got, wantGrant)
+	}
+
+	v.Schema = ""
+	const wantRevoke = `ALTER DEFAULT PRIVILEGES FOR ROLE "owner" REVOKE INSERT, SELECT ON TABLES FROM "foo"`
+	if got := v.revokeStatement(v.Privileges); got != wantRevoke {
+		t.Errorf("revokeStatement() = %v, want %v", got, wantRevoke)
+	}
+}
+
+func Test_validatePostgresDefaultPrivileges(t *testing.T) {
+	if err := validatePostgresDefaultPrivileges(postgresDefaultPrivileges{
+		ObjectType: postgresObjectFunction, Privileges: []string{"EXECUTE"},
+	}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := validatePostgresDefaultPrivileges(postgresDefaultPrivileges{
+		ObjectType: postgresObjectSchema, Schema: "public", Privileges: []string{"USAGE"},
+	}); err == nil {
+		t.Error("error expected")
+	}
+	if err := validatePostgresDefaultPrivileges(postgresDefaultPrivileges{
+		ObjectType: postgresObjectTable, Privileges: []string{"EXECUTE"},
+	}); err == nil {
+		t.Error("error expected")
+	}
+}
+
+func Test_parsePostgresDefaultPrivilegesID(t *testing.T) {
+	for _, id := range []string{
+		"myproject/br-foo/neondb/foo/owner/table/",
+		"myproject/br-foo/neondb/foo/owner/sequence/public",
+	} {
+		r, err := parsePostgresDefaultPrivilegesID(id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if r.toString() != id {
+			t.Errorf("unexpected ID: want=%s, got=%s", id, r.toString())
+		}
+	}
+
+	for _, id := range []string{
+		"myproject/br-foo/neondb/foo/owner/table",
+		"myproject/br-foo/neondb/foo//table/",
+	} {
+		if _, err := parsePostgresDefaultPrivilegesID(id); err == nil {
+			t.Errorf("error expected for the ID %s", id)
+		}
+	}
+}
+
+func Test_resourcePostgresDefaultPrivileges(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	meta := newTestSQLMeta(t)
+
+	execTestSQL(t, meta, `CREATE ROLE default_privileges_test_role`, `CREATE SCHEMA default_privileges_test`)
+	t.Cleanup(func() {
+		execTestSQL(t, meta,
+			`DROP SCHEMA default_privileges_test CASCADE`,
+			`DROP OWNED BY default_privileges_test_role`,
+			`DROP ROLE default_privileges_test_role`,
+		)
+	})
+
+	definition := resourcePostgresDefaultPrivileges().TestResourceData()
+	_ = definition.Set("project_id", "myproject")
+	_ = definition.Set("branch_id", "br-foo")
+	_ = definition.Set("database", "postgres")
+	_ = definition.Set("role", "default_privileges_test_role")
+	_ = definition.Set("owner", "postgres")
+	_ = definition.Set("object_type", postgresObjectTable)
+	_ = definition.Set("schema", "default_privileges_test")
+	_ = definition.Set("privileges", []string{"SELECT"})
+
+	if err := resourcePostgresDefaultPrivilegesCreate(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	const wantID = "myproject/br-foo/postgres/default_privileges_test_role/postgres/table/default_privileges_test"
+	if definition.Id() != wantID {
+		t.Errorf("unexpected resource ID: want=%s, got=%s", wantID, definition.Id())
+	}
+	if got, want := getStringSet(definition.Get("privileges")), []string{"SELECT"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected privileges: want=%v, got=%v", want, got)
+	}
+
+	imported := resourcePostgresDefaultPrivileges().TestResourceData()
+	imported.SetId(wantID)
+	if _, err := resourcePostgresDefaultPrivilegesImport(context.TODO(), imported, meta); err != nil {
+		t.Fatalf("unexpected import error: %v", err)
+	}
+
+	if err := resourcePostgresDefaultPrivilegesDelete(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := resourcePostgresDefaultPrivilegesRead(context.TODO(), imported, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v := getStringSet(imported.Get("privileges")); len(v) != 0 {
+		t.Errorf("default privileges expected to be revoked, got %v", v)
+	}
+}
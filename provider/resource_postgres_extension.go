@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
+)
+
+func resourcePostgresExtension() *schema.Resource {
+	return &schema.Resource{
+		Description: `Postgres extension in the database of the branch. See details: https://neon.com/docs/extensions/pg-extensions
+
+The resource connects to the database via the branch's read-write endpoint, hence the endpoint must exist.
+`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePostgresExtensionImport,
+		},
+		CreateContext: resourcePostgresExtensionCreateRetry,
+		ReadContext:   resourcePostgresExtensionReadRetry,
+		UpdateContext: resourcePostgresExtensionUpdateRetry,
+		DeleteContext: resourcePostgresExtensionDeleteRetry,
+		Schema: newSchemaSQLTarget(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Extension name, e.g. vector, postgis.",
+			},
+			"schema": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Schema to install the extension's objects in. The current default schema is used if not set.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Extension version. The default version of the extension is installed if not set.",
+			},
+			"cascade": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: `Install the extensions the extension depends on upon creation,
+and drop the objects which depend on the extension upon deletion.`,
+			},
+		}),
+	}
+}
+
+func newCreateExtensionStatement(name, schema, version string, cascade bool) string {
+	var b strings.Builder
+	b.WriteString("CREATE EXTENSION IF NOT EXISTS " + quoteIdentifier(name))
+	if schema != "" {
+		b.WriteString(" SCHEMA " + quoteIdentifier(schema))
+	}
+	if version != "" {
+		b.WriteString(" VERSION " + quoteLiteral(version))
+	}
+	if cascade {
+		b.WriteString(" CASCADE")
+	}
+	return b.String()
+}
+
+func newDropExtensionStatement(name string, cascade bool) string {
+	s := "DROP EXTENSION IF EXISTS " + quoteIdentifier(name)
+	if cascade {
+		s += " CASCADE"
+	}
+	return s
+}
+
+func resourcePostgresExtensionCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresExtensionCreate, ctx, d, meta)
+}
+
+func resourcePostgresExtensionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	t := newSQLTarget(d)
+	r := newSQLObjectID(t, d.Get("name").(string))
+	tflog.Trace(ctx, "create Postgres Extension", map[string]interface{}{"id": r.toString()})
+
+	q := newCreateExtensionStatement(
+		r.Name, d.Get("schema").(string), d.Get("version").(string), d.Get("cascade").(bool),
+	)
+	if err := withSQLConn(ctx, meta, t, func(conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx, q)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(r.toString())
+	return resourcePostgresExtensionRead(ctx, d, meta)
+}
+
+func resourcePostgresExtensionReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLRead(resourcePostgresExtensionRead, ctx, d, meta)
+}
+
+func resourcePostgresExtensionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "read Postgres Extension", map[string]interface{}{"id": d.Id()})
+
+	var schemaName, version string
+	err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		return conn.QueryRow(ctx,
+			`SELECT n.nspname, e.extversion
+FROM pg_catalog.pg_extension e
+JOIN pg_catalog.pg_namespace n ON n.oid = e.extnamespace
+WHERE e.extname = $1`,
+			d.Get("name").(string),
+		).Scan(&schemaName, &version)
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		tflog.Debug(ctx, "Postgres extension not found, removing from state", map[string]interface{}{"id": d.Id()})
+		d.SetId("")
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if err := d.Set("schema", schemaName); err != nil {
+		return err
+	}
+	return d.Set("version", version)
+}
+
+func resourcePostgresExtensionUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresExtensionUpdate, ctx, d, meta)
+}
+
+func resourcePostgresExtensionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "update Postgres Extension", map[string]interface{}{"id": d.Id()})
+
+	name := quoteIdentifier(d.Get("name").(string))
+
+	var statements []string
+	if v := d.Get("schema").(string); d.HasChange("schema") && v != "" {
+		statements = append(statements, "ALTER EXTENSION "+name+" SET SCHEMA "+quoteIdentifier(v))
+	}
+	if v := d.Get("version").(string); d.HasChange("version") && v != "" {
+		statements = append(statements, "ALTER EXTENSION "+name+" UPDATE TO "+quoteLiteral(v))
+	}
+
+	if len(statements) > 0 {
+		if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+			for _, q := range statements {
+				if _, err := conn.Exec(ctx, q); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return resourcePostgresExtensionRead(ctx, d, meta)
+}
+
+func resourcePostgresExtensionDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLDelete(resourcePostgresExtensionDelete, ctx, d, meta)
+}
+
+func resourcePostgresExtensionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "delete Postgres Extension", map[string]interface{}{"id": d.Id()})
+
+	q := newDropExtensionStatement(d.Get("name").(string), d.Get("cascade").(bool))
+	if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx, q)
+		return err
+	}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourcePostgresExtensionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import Postgres Extension")
+
+	r, err := parseSQLObjectID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	setResourceAttrsFromSQLObjectID(d, r)
+	_ = d.Set("cascade", false)
+
+	if diags := projectReadiness.Retry(resourcePostgresExtensionRead, ctx, d, meta); diags.HasError() {
+		setResourceAttrsFromSQLObjectID(d, sqlObjectID{})
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("no Postgres extension found")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
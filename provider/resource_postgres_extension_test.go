@@ -0,0 +1,99 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+)
+
+func Test_newCreateExtensionStatement(t *testing.T) {
+	tests := []struct {
+		name    string
+		ext     string
+		schema  string
+		version string
+		cascade bool
+		want    string
+	}{
+		{
+			name: "defaults",
+			ext:  "vector",
+			want: `CREATE EXTENSION IF NOT EXISTS "vector"`,
+		},
+		{
+			name:    "all options",
+			ext:     "postgis",
+			schema:  "gis",
+			version: "3.5.0",
+			cascade: true,
+			want:    `CREATE EXTENSION IF NOT EXISTS "postgis" SCHEMA "gis" VERSION '3.5.0' CASCADE`,
+		},
+		{
+			name: "shall quote the identifiers",
+			ext:  `foo"bar`,
+			want: `CREATE EXTENSION IF NOT EXISTS "foo""bar"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newCreateExtensionStatement(tt.ext, tt.schema, tt.version, tt.cascade); got != tt.want {
+				t.Errorf("newCreateExtensionStatement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_resourcePostgresExtension(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	meta := newTestSQLMeta(t)
+
+	definition := resourcePostgresExtension().TestResourceData()
+	_ = definition.Set("project_id", "myproject")
+	_ = definition.Set("branch_id", "br-foo")
+	_ = definition.Set("database", "postgres")
+	_ = definition.Set("name", "pg_trgm")
+
+	if err := resourcePostgresExtensionCreate(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = resourcePostgresExtensionDelete(context.TODO(), definition, meta)
+	})
+
+	if want := "myproject/br-foo/postgres/pg_trgm"; definition.Id() != want {
+		t.Errorf("unexpected resource ID: want=%s, got=%s", want, definition.Id())
+	}
+	if v := definition.Get("schema").(string); v != "public" {
+		t.Errorf("unexpected schema: %s", v)
+	}
+	if v := definition.Get("version").(string); v == "" {
+		t.Error("version expected to be set")
+	}
+
+	imported := resourcePostgresExtension().TestResourceData()
+	imported.SetId(definition.Id())
+	if _, err := resourcePostgresExtensionImport(context.TODO(), imported, meta); err != nil {
+		t.Fatalf("unexpected import error: %v", err)
+	}
+	if imported.Get("version").(string) != definition.Get("version").(string) {
+		t.Errorf("unexpected imported version: %s", imported.Get("version"))
+	}
+
+	if err := resourcePostgresExtensionDelete(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	definition.SetId("myproject/br-foo/postgres/pg_trgm")
+	if err := resourcePostgresExtensionRead(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if definition.Id() != "" {
+		t.Error("deleted extension expected to be removed from the state")
+	}
+}
@@ -0,0 +1,562 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
+)
+
+const (
+	postgresObjectDatabase = "database"
+	postgresObjectSchema   = "schema"
+	postgresObjectTable    = "table"
+	postgresObjectSequence = "sequence"
+	postgresObjectFunction = "function"
+)
+
+// postgresPrivileges defines the privileges which can be granted on the objects of the given type.
+var postgresPrivileges = map[string][]string{
+	postgresObjectDatabase: {"CONNECT", "CREATE", "TEMPORARY"},
+	postgresObjectSchema:   {"CREATE", "USAGE"},
+	postgresObjectTable:    {"DELETE", "INSERT", "REFERENCES", "SELECT", "TRIGGER", "TRUNCATE", "UPDATE"},
+	postgresObjectSequence: {"SELECT", "UPDATE", "USAGE"},
+	postgresObjectFunction: {"EXECUTE"},
+}
+
+var postgresGrantObjectTypes = []string{
+	postgresObjectDatabase, postgresObjectSchema, postgresObjectTable, postgresObjectSequence, postgresObjectFunction,
+}
+
+func resourcePostgresGrant() *schema.Resource {
+	return &schema.Resource{
+		Description: `Privileges of the Postgres role on the database objects. See details: https://www.postgresql.org/docs/current/sql-grant.html
+
+The resource is authoritative for the privileges of the role on the objects,
+hence the privileges granted outside of the resource are revoked.
+`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePostgresGrantImport,
+		},
+		CreateContext: resourcePostgresGrantCreateRetry,
+		ReadContext:   resourcePostgresGrantReadRetry,
+		UpdateContext: resourcePostgresGrantUpdateRetry,
+		DeleteContext: resourcePostgresGrantDeleteRetry,
+		CustomizeDiff: resourcePostgresGrantCustomizeDiff,
+		Schema: newSchemaSQLTarget(map[string]*schema.Schema{
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: `Role to grant the privileges to. Use "public" to grant the privileges to all roles.`,
+			},
+			"object_type": newSchemaPostgresObjectType(postgresGrantObjectTypes...),
+			"schema": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Description: `Schema name. Required unless the object type is "database".
+It's the object to grant the privileges on if the object type is "schema".`,
+			},
+			"objects": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: `Names of the tables, sequences, or functions to grant the privileges on.
+The privileges are granted on all objects of the type in the schema if not set.`,
+			},
+			"privileges": newSchemaPostgresPrivileges(postgresGrantObjectTypes...),
+			"with_grant_option": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Allow the role to grant the privileges to other roles.",
+			},
+		}),
+	}
+}
+
+func newSchemaPostgresObjectType(types ...string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: `Type of the objects. Allowed values: "` + strings.Join(types, `", "`) + `".`,
+		ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+			if v := i.(string); !slices.Contains(types, v) {
+				errs = append(errs, errors.New(v+" is not supported value for "+s))
+			}
+			return
+		},
+	}
+}
+
+func newSchemaPostgresPrivileges(types ...string) *schema.Schema {
+	var b strings.Builder
+	b.WriteString(`Privileges to grant, e.g. "SELECT", "USAGE". Allowed values depend on the object type:`)
+	for i, t := range types {
+		b.WriteString("\n- " + t + `: "` + strings.Join(postgresPrivileges[t], `", "`) + `"`)
+		if i < len(types)-1 {
+			b.WriteString(";")
+		} else {
+			b.WriteString(".")
+		}
+	}
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Required:    true,
+		MinItems:    1,
+		Elem:        &schema.Schema{Type: schema.TypeString},
+		Description: b.String(),
+	}
+}
+
+func validatePostgresPrivileges(objectType string, privileges []string) error {
+	for _, v := range privileges {
+		if !slices.Contains(postgresPrivileges[objectType], v) {
+			return fmt.Errorf("privilege %s is not supported for the object type %s, allowed values: %s",
+				v, objectType, strings.Join(postgresPrivileges[objectType], ", "))
+		}
+	}
+	return nil
+}
+
+func validatePostgresGrant(v postgresGrant) error {
+	switch v.ObjectType {
+	case postgresObjectDatabase:
+		if v.Schema != "" {
+			return errors.New("schema cannot be set for the object type database")
+		}
+	default:
+		if v.Schema == "" {
+			return errors.New("schema must be set for the object type " + v.ObjectType)
+		}
+	}
+
+	switch v.ObjectType {
+	case postgresObjectDatabase, postgresObjectSchema:
+		if len(v.Objects) > 0 {
+			return errors.New("objects cannot be set for the object type " + v.ObjectType)
+		}
+	}
+
+	return validatePostgresPrivileges(v.ObjectType, v.Privileges)
+}
+
+func resourcePostgresGrantCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	return validatePostgresGrant(postgresGrant{
+		ObjectType: d.Get("object_type").(string),
+		Schema:     d.Get("schema").(string),
+		Objects:    getStringSet(d.Get("objects")),
+		Privileges: getStringSet(d.Get("privileges")),
+	})
+}
+
+// getStringSet returns the sorted elements of the set of strings.
+func getStringSet(v interface{}) []string {
+	s, ok := v.(*schema.Set)
+	if !ok {
+		return nil
+	}
+	var o = make([]string, 0, s.Len())
+	for _, el := range s.List() {
+		o = append(o, el.(string))
+	}
+	slices.Sort(o)
+	return o
+}
+
+// postgresGrant defines the privileges of the role on the database objects.
+type postgresGrant struct {
+	Database, Role, ObjectType, Schema string
+	Objects, Privileges                []string
+	WithGrantOption                    bool
+}
+
+func newPostgresGrant(d *schema.ResourceData) postgresGrant {
+	return postgresGrant{
+		Database:        d.Get("database").(string),
+		Role:            d.Get("role").(string),
+		ObjectType:      d.Get("object_type").(string),
+		Schema:          d.Get("schema").(string),
+		Objects:         getStringSet(d.Get("objects")),
+		Privileges:      getStringSet(d.Get("privileges")),
+		WithGrantOption: d.Get("with_grant_option").(bool),
+	}
+}
+
+// quoteRole quotes the role name, the role "public" stands for all roles.
+func quoteRole(s string) string {
+	if strings.EqualFold(s, "public") {
+		return "PUBLIC"
+	}
+	return quoteIdentifier(s)
+}
+
+// on returns the objects' definition in the GRANT statement.
+func (v postgresGrant) on() string {
+	switch v.ObjectType {
+	case postgresObjectDatabase:
+		return "DATABASE " + quoteIdentifier(v.Database)
+	case postgresObjectSchema:
+		return "SCHEMA " + quoteIdentifier(v.Schema)
+	}
+
+	keyword := strings.ToUpper(v.ObjectType)
+	if len(v.Objects) == 0 {
+		return "ALL " + keyword + "S IN SCHEMA " + quoteIdentifier(v.Schema)
+	}
+
+	var objects = make([]string, len(v.Objects))
+	for i, o := range v.Objects {
+		objects[i] = quoteIdentifier(v.Schema) + "." + quoteIdentifier(o)
+	}
+	return keyword + " " + strings.Join(objects, ", ")
+}
+
+func (v postgresGrant) grantStatement(privileges []string) string {
+	s := "GRANT " + strings.Join(privileges, ", ") + " ON " + v.on() + " TO " + quoteRole(v.Role)
+	if v.WithGrantOption {
+		s += " WITH GRANT OPTION"
+	}
+	return s
+}
+
+func (v postgresGrant) revokeStatement(privileges []string) string {
+	return "REVOKE " + strings.Join(privileges, ", ") + " ON " + v.on() + " FROM " + quoteRole(v.Role)
+}
+
+// postgresGranteeExpression returns the name of the grantee from the output of the function aclexplode.
+const postgresGranteeExpression = `CASE a.grantee WHEN 0 THEN 'PUBLIC' ELSE pg_catalog.pg_get_userbyid(a.grantee) END`
+
+// privilegesQuery returns the query to list the privileges of the role on all objects of the type.
+// The query's output contains the object name, and the privilege which is null if the role has no privileges.
+// The privileges on the tables and the functions are read from the information_schema,
+// the privileges on the other objects are read from the system catalogs because the information_schema
+// does not expose them.
+func (v postgresGrant) privilegesQuery() (string, []interface{}) {
+	role := v.Role
+	if strings.EqualFold(role, "public") {
+		role = "PUBLIC"
+	}
+
+	switch v.ObjectType {
+	case postgresObjectDatabase:
+		return `SELECT d.datname::text, a.privilege_type
+FROM pg_catalog.pg_database d
+LEFT JOIN LATERAL aclexplode(COALESCE(d.datacl, acldefault('d', d.datdba))) a
+	ON ` + postgresGranteeExpression + ` = $2
+WHERE d.datname = $1`, []interface{}{v.Database, role}
+	case postgresObjectSchema:
+		return `SELECT n.nspname::text, a.privilege_type
+FROM pg_catalog.pg_namespace n
+LEFT JOIN LATERAL aclexplode(COALESCE(n.nspacl, acldefault('n', n.nspowner))) a
+	ON ` + postgresGranteeExpression + ` = $2
+WHERE n.nspname = $1`, []interface{}{v.Schema, role}
+	case postgresObjectSequence:
+		return `SELECT c.relname::text, a.privilege_type
+FROM pg_catalog.pg_class c
+JOIN pg_catalog.pg_namespace n ON n.oid = c.relnamespace
+LEFT JOIN LATERAL aclexplode(COALESCE(c.relacl, acldefault('s', c.relowner))) a
+	ON ` + postgresGranteeExpression + ` = $2
+WHERE c.relkind = 'S' AND n.nspname = $1`, []interface{}{v.Schema, role}
+	case postgresObjectFunction:
+		return `SELECT r.routine_name::text, p.privilege_type::text
+FROM information_schema.routines r
+LEFT JOIN information_schema.routine_privileges p
+	ON p.specific_schema = r.specific_schema AND p.specific_name = r.specific_name AND p.grantee::text = $2
+WHERE r.routine_schema::text = $1`, []interface{}{v.Schema, role}
+	default:
+		return `SELECT t.table_name::text, p.privilege_type::text
+FROM information_schema.tables t
+LEFT JOIN information_schema.table_privileges p
+	ON p.table_schema = t.table_schema AND p.table_name = t.table_name AND p.grantee::text = $2
+WHERE t.table_schema::text = $1`, []interface{}{v.Schema, role}
+	}
+}
+
+// readPostgresPrivileges returns the privileges of the role on the objects, mapped to the objects' names.
+func readPostgresPrivileges(ctx context.Context, conn *pgx.Conn, v postgresGrant) (map[string][]string, error) {
+	q, args := v.privilegesQuery()
+	rows, err := conn.Query(ctx, q, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var o = map[string][]string{}
+	for rows.Next() {
+		var (
+			object    string
+			privilege *string
+		)
+		if err := rows.Scan(&object, &privilege); err != nil {
+			return nil, err
+		}
+		if _, ok := o[object]; !ok {
+			o[object] = nil
+		}
+		if privilege != nil && slices.Contains(postgresPrivileges[v.ObjectType], *privilege) &&
+			!slices.Contains(o[object], *privilege) {
+			o[object] = append(o[object], *privilege)
+		}
+	}
+	return o, rows.Err()
+}
+
+// observedPostgresPrivileges returns the privileges of the role on the objects to keep in the state:
+// the expected privileges the role has on all objects, and the unexpected privileges the role has on any object.
+// Hence, the update grants the expected privileges missing on any object, and revokes the unexpected privileges.
+// The objects which do not exist are considered to have no privileges.
+// It returns false if there are no objects to observe.
+func observedPostgresPrivileges(privileges map[string][]string, objects, expected []string) ([]string, bool) {
+	if len(objects) == 0 {
+		for k := range privileges {
+			objects = append(objects, k)
+		}
+	}
+	if len(objects) == 0 {
+		return nil, false
+	}
+
+	var o []string
+	for _, privilege := range expected {
+		if !slices.ContainsFunc(objects, func(object string) bool {
+			return !slices.Contains(privileges[object], privilege)
+		}) {
+			o = append(o, privilege)
+		}
+	}
+	for _, object := range objects {
+		for _, privilege := range privileges[object] {
+			if !slices.Contains(expected, privilege) && !slices.Contains(o, privilege) {
+				o = append(o, privilege)
+			}
+		}
+	}
+	slices.Sort(o)
+	return o, true
+}
+
+// difference returns the elements of a which are not in b.
+func difference(a, b []string) []string {
+	var o []string
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			o = append(o, v)
+		}
+	}
+	return o
+}
+
+func resourcePostgresGrantCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresGrantCreate, ctx, d, meta)
+}
+
+func resourcePostgresGrantCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	t := newSQLTarget(d)
+	v := newPostgresGrant(d)
+	r := postgresGrantID{
+		sqlObjectID: newSQLObjectID(t, v.Role),
+		ObjectType:  v.ObjectType,
+		Schema:      v.Schema,
+		Objects:     v.Objects,
+	}
+	tflog.Trace(ctx, "create Postgres Grant", map[string]interface{}{"id": r.toString()})
+
+	if err := withSQLConn(ctx, meta, t, func(conn *pgx.Conn) error {
+		privileges, err := readPostgresPrivileges(ctx, conn, v)
+		if err != nil {
+			return err
+		}
+		// the resource is authoritative, hence the privileges granted beforehand are revoked
+		if current, ok := observedPostgresPrivileges(privileges, v.Objects, v.Privileges); ok {
+			if revoke := difference(current, v.Privileges); len(revoke) > 0 {
+				if _, err := conn.Exec(ctx, v.revokeStatement(revoke)); err != nil {
+					return err
+				}
+			}
+		}
+		_, err = conn.Exec(ctx, v.grantStatement(v.Privileges))
+		return err
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(r.toString())
+	return resourcePostgresGrantRead(ctx, d, meta)
+}
+
+func resourcePostgresGrantReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLRead(resourcePostgresGrantRead, ctx, d, meta)
+}
+
+func resourcePostgresGrantRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "read Postgres Grant", map[string]interface{}{"id": d.Id()})
+
+	v := newPostgresGrant(d)
+	var privileges map[string][]string
+	if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) (err error) {
+		privileges, err = readPostgresPrivileges(ctx, conn, v)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	switch v.ObjectType {
+	case postgresObjectDatabase, postgresObjectSchema:
+		if len(privileges) == 0 {
+			tflog.Debug(ctx, v.ObjectType+" not found, removing Postgres Grant from state",
+				map[string]interface{}{"id": d.Id()})
+			d.SetId("")
+			return nil
+		}
+	}
+
+	current, ok := observedPostgresPrivileges(privileges, v.Objects, v.Privileges)
+	if !ok {
+		tflog.Debug(ctx, "no objects found in the schema, Postgres Grant state is kept",
+			map[string]interface{}{"id": d.Id()})
+		return nil
+	}
+	return d.Set("privileges", current)
+}
+
+func resourcePostgresGrantUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresGrantUpdate, ctx, d, meta)
+}
+
+func resourcePostgresGrantUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "update Postgres Grant", map[string]interface{}{"id": d.Id()})
+
+	if d.HasChange("privileges") {
+		v := newPostgresGrant(d)
+		o, n := d.GetChange("privileges")
+		oldPrivileges, newPrivileges := getStringSet(o), getStringSet(n)
+
+		if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+			if revoke := difference(oldPrivileges, newPrivileges); len(revoke) > 0 {
+				if _, err := conn.Exec(ctx, v.revokeStatement(revoke)); err != nil {
+					return err
+				}
+			}
+			if grant := difference(newPrivileges, oldPrivileges); len(grant) > 0 {
+				if _, err := conn.Exec(ctx, v.grantStatement(grant)); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return resourcePostgresGrantRead(ctx, d, meta)
+}
+
+func resourcePostgresGrantDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLDelete(resourcePostgresGrantDelete, ctx, d, meta)
+}
+
+func resourcePostgresGrantDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "delete Postgres Grant", map[string]interface{}{"id": d.Id()})
+
+	v := newPostgresGrant(d)
+	if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx, v.revokeStatement(v.Privileges))
+		return err
+	}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourcePostgresGrantImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import Postgres Grant")
+
+	r, err := parsePostgresGrantID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	_ = d.Set("project_id", r.ProjectID)
+	_ = d.Set("branch_id", r.BranchID)
+	_ = d.Set("database", r.Database)
+	_ = d.Set("role", r.Name)
+	_ = d.Set("object_type", r.ObjectType)
+	_ = d.Set("schema", r.Schema)
+	_ = d.Set("objects", r.Objects)
+	_ = d.Set("with_grant_option", false)
+
+	if diags := projectReadiness.Retry(resourcePostgresGrantRead, ctx, d, meta); diags.HasError() {
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("no Postgres Grant found")
+	}
+	if len(getStringSet(d.Get("privileges"))) == 0 {
+		d.SetId("")
+		return nil, errors.New("the role has no privileges on the objects")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// postgresGrantID is the identifier of the grant which follows the template:
+// {{.ProjectID}}/{{.BranchID}}/{{.Database}}/{{.Role}}/{{.ObjectType}}/{{.Schema}}/{{.Objects}},
+// where the schema is empty for the object type database, and the objects are separated by comma.
+// The name of the embedded sqlObjectID is the role.
+type postgresGrantID struct {
+	sqlObjectID
+	ObjectType, Schema string
+	Objects            []string
+}
+
+func (v postgresGrantID) toString() string {
+	r := v.sqlObjectID
+	r.Name = strings.Join([]string{v.Name, v.ObjectType, v.Schema, strings.Join(v.Objects, ",")}, "/")
+	return r.toString()
+}
+
+func parsePostgresGrantID(s string) (postgresGrantID, error) {
+	errTemplate := errors.New("ID of this resource type shall follow the template: " +
+		"{{.ProjectID}}/{{.BranchID}}/{{.Database}}/{{.Role}}/{{.ObjectType}}/{{.Schema}}/{{.Objects}}",
+	)
+
+	r, err := parseSQLObjectID(s)
+	if err != nil {
+		return postgresGrantID{}, errTemplate
+	}
+	spl := strings.SplitN(r.Name, "/", 4)
+	if len(spl) != 4 || r.ProjectID == "" || r.BranchID == "" || r.Database == "" || spl[0] == "" || spl[1] == "" {
+		return postgresGrantID{}, errTemplate
+	}
+
+	var objects []string
+	if spl[3] != "" {
+		objects = strings.Split(spl[3], ",")
+		slices.Sort(objects)
+	}
+
+	r.Name = spl[0]
+	return postgresGrantID{
+		sqlObjectID: r,
+		ObjectType:  spl[1],
+		Schema:      spl[2],
+		Objects:     objects,
+	}, nil
+}
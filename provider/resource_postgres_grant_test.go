@@ -0,0 +1,267 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func Test_postgresGrant_statements(t *testing.T) {
+	tests := []struct {
+		name       string
+		v          postgresGrant
+		wantGrant  string
+		wantRevoke string
+	}{
+		{
+			name: "database",
+			v: postgresGrant{
+				Database: "neondb", Role: "foo", ObjectType: postgresObjectDatabase,
+				Privileges: []string{"CONNECT", "TEMPORARY"},
+			},
+			wantGrant:  `GRANT CONNECT, TEMPORARY ON DATABASE "neondb" TO "foo"`,
+			wantRevoke: `REVOKE CONNECT, TEMPORARY ON DATABASE "neondb" FROM "foo"`,
+		},
+		{
+			name: "schema with grant option",
+			v: postgresGrant{
+				Role: "foo", ObjectType: postgresObjectSchema, Schema: "app", Privileges: []string{"USAGE"},
+				WithGrantOption: true,
+			},
+			wantGrant:  `GRANT USAGE ON SCHEMA "app" TO "foo" WITH GRANT OPTION`,
+			wantRevoke: `REVOKE USAGE ON SCHEMA "app" FROM "foo"`,
+		},
+		{
+			name: "all tables in schema to public",
+			v: postgresGrant{
+				Role: "public", ObjectType: postgresObjectTable, Schema: "app", Privileges: []string{"SELECT"},
+			},
+			wantGrant:  `GRANT SELECT ON ALL TABLES IN SCHEMA "app" TO PUBLIC`,
+			wantRevoke: `REVOKE SELECT ON ALL TABLES IN SCHEMA "app" FROM PUBLIC`,
+		},
+		{
+			name: "sequences",
+			v: postgresGrant{
+				Role: "foo", ObjectType: postgresObjectSequence, Schema: "app", Objects: []string{"bar", "baz"},
+				Privileges: []string{"USAGE"},
+			},
+			wantGrant:  `GRANT USAGE ON SEQUENCE "app"."bar", "app"."baz" TO "foo"`,
+			wantRevoke: `REVOKE USAGE ON SEQUENCE "app"."bar", "app"."baz" FROM "foo"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.v.grantStatement(tt.v.Privileges); got != tt.wantGrant {
+				t.Errorf("grantStatement() = %v, want %v", got, tt.wantGrant)
+			}
+			if got := tt.v.revokeStatement(tt.v.Privileges); got != tt.wantRevoke {
+				t.Errorf("revokeStatement() = %v, want %v", got, tt.wantRevoke)
+			}
+		})
+	}
+}
+
+func Test_validatePostgresGrant(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       postgresGrant
+		wantErr bool
+	}{
+		{
+			name: "database",
+			v:    postgresGrant{ObjectType: postgresObjectDatabase, Privileges: []string{"CONNECT"}},
+		},
+		{
+			name: "tables",
+			v: postgresGrant{
+				ObjectType: postgresObjectTable, Schema: "public", Objects: []string{"foo"},
+				Privileges: []string{"SELECT", "INSERT"},
+			},
+		},
+		{
+			name:    "unhappy path: schema set for database",
+			v:       postgresGrant{ObjectType: postgresObjectDatabase, Schema: "public", Privileges: []string{"CONNECT"}},
+			wantErr: true,
+		},
+		{
+			name:    "unhappy path: no schema for tables",
+			v:       postgresGrant{ObjectType: postgresObjectTable, Privileges: []string{"SELECT"}},
+			wantErr: true,
+		},
+		{
+			name: "unhappy path: objects set for schema",
+			v: postgresGrant{
+				ObjectType: postgresObjectSchema, Schema: "public", Objects: []string{"foo"},
+				Privileges: []string{"USAGE"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "unhappy path: privilege not supported by the object type",
+			v:       postgresGrant{ObjectType: postgresObjectSchema, Schema: "public", Privileges: []string{"SELECT"}},
+			wantErr: true,
+		},
+		{
+			name:    "unhappy path: lower case privilege",
+			v:       postgresGrant{ObjectType: postgresObjectSchema, Schema: "public", Privileges: []string{"usage"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validatePostgresGrant(tt.v); (err != nil) != tt.wantErr {
+				t.Errorf("validatePostgresGrant() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_observedPostgresPrivileges(t *testing.T) {
+	privileges := map[string][]string{
+		"foo": {"SELECT", "INSERT"},
+		"bar": {"INSERT", "SELECT", "UPDATE"},
+		"baz": nil,
+	}
+
+	tests := []struct {
+		name     string
+		objects  []string
+		expected []string
+		want     []string
+	}{
+		{
+			name:     "no drift",
+			objects:  []string{"foo", "bar"},
+			expected: []string{"INSERT", "SELECT", "UPDATE"},
+			want:     []string{"INSERT", "SELECT"},
+		},
+		{
+			name:     "unexpected privilege on one object",
+			objects:  []string{"foo", "bar"},
+			expected: []string{"INSERT", "SELECT"},
+			want:     []string{"INSERT", "SELECT", "UPDATE"},
+		},
+		{
+			name:     "expected privilege missing on all objects",
+			expected: []string{"SELECT"},
+			want:     []string{"INSERT", "UPDATE"},
+		},
+		{
+			name:     "missing object",
+			objects:  []string{"foo", "qux"},
+			expected: []string{"SELECT", "INSERT"},
+			want:     nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := observedPostgresPrivileges(privileges, tt.objects, tt.expected)
+			if !ok {
+				t.Fatal("objects expected to be found")
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("observedPostgresPrivileges() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if _, ok := observedPostgresPrivileges(map[string][]string{}, nil, []string{"SELECT"}); ok {
+		t.Error("no objects expected to be found")
+	}
+}
+
+func Test_parsePostgresGrantID(t *testing.T) {
+	for _, id := range []string{
+		"myproject/br-foo/neondb/foo/database//",
+		"myproject/br-foo/neondb/foo/table/public/bar,baz",
+	} {
+		r, err := parsePostgresGrantID(id)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if r.toString() != id {
+			t.Errorf("unexpected ID: want=%s, got=%s", id, r.toString())
+		}
+	}
+
+	if _, err := parsePostgresGrantID("myproject/br-foo/neondb/foo"); err == nil {
+		t.Error("error expected")
+	}
+}
+
+// execTestSQL executes the statements in the local Postgres instance.
+func execTestSQL(t *testing.T, meta *sdkClientStub, statements ...string) {
+	t.Helper()
+	if err := withSQLConn(context.TODO(), meta, sqlTarget{}, func(conn *pgx.Conn) error {
+		for _, q := range statements {
+			if _, err := conn.Exec(context.TODO(), q); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func Test_resourcePostgresGrant(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	meta := newTestSQLMeta(t)
+
+	execTestSQL(t, meta,
+		`CREATE ROLE grant_test_role`,
+		`CREATE SCHEMA grant_test`,
+		`CREATE TABLE grant_test.foo (id int)`,
+		`CREATE TABLE grant_test.bar (id int)`,
+	)
+	t.Cleanup(func() {
+		execTestSQL(t, meta, `DROP SCHEMA grant_test CASCADE`, `DROP ROLE grant_test_role`)
+	})
+
+	definition := resourcePostgresGrant().TestResourceData()
+	_ = definition.Set("project_id", "myproject")
+	_ = definition.Set("branch_id", "br-foo")
+	_ = definition.Set("database", "postgres")
+	_ = definition.Set("role", "grant_test_role")
+	_ = definition.Set("object_type", postgresObjectTable)
+	_ = definition.Set("schema", "grant_test")
+	_ = definition.Set("privileges", []string{"SELECT", "INSERT"})
+
+	// the privilege granted outside the resource shall be revoked
+	execTestSQL(t, meta, `GRANT DELETE ON grant_test.foo TO grant_test_role`)
+
+	if err := resourcePostgresGrantCreate(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "myproject/br-foo/postgres/grant_test_role/table/grant_test/"; definition.Id() != want {
+		t.Errorf("unexpected resource ID: want=%s, got=%s", want, definition.Id())
+	}
+	if got, want := getStringSet(definition.Get("privileges")), []string{"INSERT", "SELECT"}; !reflect.DeepEqual(
+		got, want,
+	) {
+		t.Errorf("unexpected privileges: want=%v, got=%v", want, got)
+	}
+
+	// drift
+	execTestSQL(t, meta, `REVOKE INSERT ON grant_test.bar FROM grant_test_role`)
+	if err := resourcePostgresGrantRead(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := getStringSet(definition.Get("privileges")), []string{"SELECT"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected privileges: want=%v, got=%v", want, got)
+	}
+
+	if err := resourcePostgresGrantDelete(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
@@ -0,0 +1,458 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
+)
+
+var postgresPolicyCommands = []string{"ALL", "SELECT", "INSERT", "UPDATE", "DELETE"}
+
+func resourcePostgresPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: `Postgres row-level security policy of the table in the database of the branch.
+See details: https://www.postgresql.org/docs/current/ddl-rowsecurity.html
+
+The changes of the expressions' whitespaces, and of the case outside of the quoted literals and identifiers are ignored.
+The expressions deparsed by Postgres upon the policy's application are recorded, and compared to the ones
+returned by the view pg_policies to detect the drift.
+The resource connects to the database via the branch's read-write endpoint, hence the endpoint must exist.
+`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePostgresPolicyImport,
+		},
+		CreateContext: resourcePostgresPolicyCreateRetry,
+		ReadContext:   resourcePostgresPolicyReadRetry,
+		UpdateContext: resourcePostgresPolicyUpdateRetry,
+		DeleteContext: resourcePostgresPolicyDeleteRetry,
+		CustomizeDiff: resourcePostgresPolicyCustomizeDiff,
+		Schema: newSchemaSQLTarget(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Policy name.",
+			},
+			"table": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+					if _, _, err := parsePostgresTableName(i.(string)); err != nil {
+						errs = append(errs, err)
+					}
+					return
+				},
+				Description: `Table to apply the policy to, defined as "schema.table", e.g. "public.users".`,
+			},
+			"command": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "ALL",
+				ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+					if v := i.(string); !slices.Contains(postgresPolicyCommands, v) {
+						errs = append(errs, fmt.Errorf("%s is not supported value for %s", v, s))
+					}
+					return
+				},
+				Description: `Command the policy applies to. Allowed values: "` +
+					strings.Join(postgresPolicyCommands, `", "`) + `".`,
+			},
+			"permissive": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+				Description: `Combine the policy with the other policies of the table using OR.
+The policy is restrictive and combined using AND if set to false.`,
+			},
+			"roles": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Description: `Roles the policy applies to, e.g. the roles mapped by the resource ` + "`neon_jwks_url`" + `.
+The policy applies to all roles if not set.`,
+			},
+			"enable_row_level_security": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+				Description: `Enable the row-level security of the table, the policy is not applied otherwise.
+The row-level security is not disabled if set to false, or upon the policy's deletion.`,
+			},
+			"using": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressPostgresExpressionDiff,
+				Description: `Expression to check the existing rows against, e.g. "user_id = auth.user_id()".
+The policy is recreated if the expression is removed.`,
+			},
+			"with_check": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressPostgresExpressionDiff,
+				Description: `Expression to check the inserted and the updated rows against.
+The policy is recreated if the expression is removed.`,
+			},
+			"using_deparsed": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expression to check the existing rows against as deparsed by Postgres.",
+			},
+			"with_check_deparsed": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expression to check the inserted and the updated rows against as deparsed by Postgres.",
+			},
+		}),
+	}
+}
+
+// normalizePostgresExpression collapses the whitespaces of the SQL expression,
+// and converts it to lower case except for the quoted literals and identifiers.
+func normalizePostgresExpression(s string) string {
+	var (
+		b     strings.Builder
+		quote byte
+		space bool
+	)
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if quote == 0 && (c == ' ' || c == '\t' || c == '\r' || c == '\n') {
+			space = true
+			continue
+		}
+		if space && b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		space = false
+
+		switch {
+		case quote != 0:
+			b.WriteByte(c)
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+			b.WriteByte(c)
+		case c >= 'A' && c <= 'Z':
+			b.WriteByte(c + 'a' - 'A')
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
+
+func suppressPostgresExpressionDiff(_, oldValue, newValue string, _ *schema.ResourceData) bool {
+	return normalizePostgresExpression(oldValue) == normalizePostgresExpression(newValue)
+}
+
+func quotePostgresRoles(roles []string) string {
+	if len(roles) == 0 {
+		return "PUBLIC"
+	}
+	var o = make([]string, len(roles))
+	for i, v := range roles {
+		o[i] = quoteRole(v)
+	}
+	return strings.Join(o, ", ")
+}
+
+func newCreatePolicyStatement(name, table, command string, permissive bool, roles []string,
+	using, withCheck string) string {
+	var b strings.Builder
+	b.WriteString("CREATE POLICY " + quoteIdentifier(name) + " ON " + quotePostgresTableNames([]string{table}))
+	if permissive {
+		b.WriteString(" AS PERMISSIVE")
+	} else {
+		b.WriteString(" AS RESTRICTIVE")
+	}
+	b.WriteString(" FOR " + command)
+	b.WriteString(" TO " + quotePostgresRoles(roles))
+	if using != "" {
+		b.WriteString(" USING (" + using + ")")
+	}
+	if withCheck != "" {
+		b.WriteString(" WITH CHECK (" + withCheck + ")")
+	}
+	return b.String()
+}
+
+func newEnableRowLevelSecurityStatement(table string) string {
+	return "ALTER TABLE " + quotePostgresTableNames([]string{table}) + " ENABLE ROW LEVEL SECURITY"
+}
+
+func resourcePostgresPolicyCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	for _, k := range []string{"using", "with_check"} {
+		if !d.HasChange(k) {
+			continue
+		}
+		if err := d.SetNewComputed(k + "_deparsed"); err != nil {
+			return err
+		}
+		// ALTER POLICY cannot remove the expression
+		if o, n := d.GetChange(k); o.(string) != "" && n.(string) == "" {
+			if err := d.ForceNew(k); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resourcePostgresPolicyCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresPolicyCreate, ctx, d, meta)
+}
+
+func resourcePostgresPolicyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	t := newSQLTarget(d)
+	r := postgresPolicyID{
+		sqlObjectID: newSQLObjectID(t, d.Get("name").(string)),
+		Table:       d.Get("table").(string),
+	}
+	tflog.Trace(ctx, "create Postgres Policy", map[string]interface{}{"id": r.toString()})
+
+	statements := []string{
+		newCreatePolicyStatement(r.Name, r.Table, d.Get("command").(string), d.Get("permissive").(bool),
+			getStringSet(d.Get("roles")), d.Get("using").(string), d.Get("with_check").(string),
+		),
+	}
+	if d.Get("enable_row_level_security").(bool) {
+		statements = append(statements, newEnableRowLevelSecurityStatement(r.Table))
+	}
+	if err := withSQLConn(ctx, meta, t, func(conn *pgx.Conn) error {
+		return pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+			for _, q := range statements {
+				if _, err := tx.Exec(ctx, q); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(r.toString())
+	return readPostgresPolicy(ctx, d, meta, true)
+}
+
+func resourcePostgresPolicyReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLRead(resourcePostgresPolicyRead, ctx, d, meta)
+}
+
+func resourcePostgresPolicyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "read Postgres Policy", map[string]interface{}{"id": d.Id()})
+	return readPostgresPolicy(ctx, d, meta, false)
+}
+
+// readPostgresPolicy reads the policy, the expressions are recorded as deparsed by Postgres if they were applied
+// by the resource, otherwise they are set to the ones returned by Postgres if they differ from the recorded ones.
+func readPostgresPolicy(ctx context.Context, d *schema.ResourceData, meta interface{}, applied bool) error {
+	schemaName, tableName, err := parsePostgresTableName(d.Get("table").(string))
+	if err != nil {
+		return err
+	}
+
+	var (
+		permissive, command, using, withCheck string
+		roles                                 []string
+		rowSecurity                           bool
+	)
+	err = withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		return conn.QueryRow(ctx,
+			`SELECT p.permissive, p.roles::text[], p.cmd, COALESCE(p.qual, ''), COALESCE(p.with_check, ''),
+	c.relrowsecurity
+FROM pg_catalog.pg_policies p
+JOIN pg_catalog.pg_namespace n ON n.nspname = p.schemaname
+JOIN pg_catalog.pg_class c ON c.relnamespace = n.oid AND c.relname = p.tablename
+WHERE p.schemaname = $1 AND p.tablename = $2 AND p.policyname = $3`,
+			schemaName, tableName, d.Get("name").(string),
+		).Scan(&permissive, &roles, &command, &using, &withCheck, &rowSecurity)
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		tflog.Debug(ctx, "Postgres policy not found, removing from state", map[string]interface{}{"id": d.Id()})
+		d.SetId("")
+		return nil
+	case err != nil:
+		return err
+	}
+
+	// the policy without roles applies to the role public
+	if slices.Equal(roles, []string{"public"}) && !slices.ContainsFunc(getStringSet(d.Get("roles")),
+		func(s string) bool { return strings.EqualFold(s, "public") },
+	) {
+		roles = nil
+	}
+
+	if err := d.Set("permissive", permissive == "PERMISSIVE"); err != nil {
+		return err
+	}
+	if err := d.Set("command", command); err != nil {
+		return err
+	}
+	if err := d.Set("roles", roles); err != nil {
+		return err
+	}
+	// the row-level security is managed only if enabled by the resource
+	if d.Get("enable_row_level_security").(bool) {
+		if err := d.Set("enable_row_level_security", rowSecurity); err != nil {
+			return err
+		}
+	}
+	for k, v := range map[string]string{"using": using, "with_check": withCheck} {
+		if !applied && v != d.Get(k+"_deparsed").(string) {
+			tflog.Debug(ctx, "Postgres policy expression changed", map[string]interface{}{"id": d.Id(), k: v})
+			if err := d.Set(k, v); err != nil {
+				return err
+			}
+		}
+		if err := d.Set(k+"_deparsed", v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourcePostgresPolicyUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresPolicyUpdate, ctx, d, meta)
+}
+
+func resourcePostgresPolicyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "update Postgres Policy", map[string]interface{}{"id": d.Id()})
+
+	var statements []string
+	if d.HasChange("enable_row_level_security") && d.Get("enable_row_level_security").(bool) {
+		statements = append(statements, newEnableRowLevelSecurityStatement(d.Get("table").(string)))
+	}
+
+	var clauses []string
+	if d.HasChange("roles") {
+		clauses = append(clauses, "TO "+quotePostgresRoles(getStringSet(d.Get("roles"))))
+	}
+	if v := d.Get("using").(string); d.HasChange("using") && v != "" {
+		clauses = append(clauses, "USING ("+v+")")
+	}
+	if v := d.Get("with_check").(string); d.HasChange("with_check") && v != "" {
+		clauses = append(clauses, "WITH CHECK ("+v+")")
+	}
+
+	if len(clauses) > 0 {
+		statements = append(statements, "ALTER POLICY "+quoteIdentifier(d.Get("name").(string))+
+			" ON "+quotePostgresTableNames([]string{d.Get("table").(string)})+" "+strings.Join(clauses, " "),
+		)
+	}
+
+	if len(statements) > 0 {
+		if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+			for _, q := range statements {
+				if _, err := conn.Exec(ctx, q); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return readPostgresPolicy(ctx, d, meta, true)
+}
+
+func resourcePostgresPolicyDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLDelete(resourcePostgresPolicyDelete, ctx, d, meta)
+}
+
+// resourcePostgresPolicyDelete drops the policy, the row-level security of the table stays enabled
+// to keep the rows protected by the remaining policies.
+func resourcePostgresPolicyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "delete Postgres Policy", map[string]interface{}{"id": d.Id()})
+
+	q := "DROP POLICY IF EXISTS " + quoteIdentifier(d.Get("name").(string)) +
+		" ON " + quotePostgresTableNames([]string{d.Get("table").(string)})
+	if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx, q)
+		return err
+	}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourcePostgresPolicyImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import Postgres Policy")
+
+	r, err := parsePostgresPolicyID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	setResourceAttrsFromPostgresPolicyID(d, r)
+	_ = d.Set("enable_row_level_security", true)
+
+	if diags := projectReadiness.Retry(resourcePostgresPolicyRead, ctx, d, meta); diags.HasError() {
+		setResourceAttrsFromPostgresPolicyID(d, postgresPolicyID{})
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("no Postgres policy found")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// postgresPolicyID is the identifier of the policy which follows the template:
+// {{.ProjectID}}/{{.BranchID}}/{{.Database}}/{{.Table}}/{{.Name}}, where the table is defined as schema.table.
+type postgresPolicyID struct {
+	sqlObjectID
+	Table string
+}
+
+func (v postgresPolicyID) toString() string {
+	r := v.sqlObjectID
+	r.Name = v.Table + "/" + v.Name
+	return r.toString()
+}
+
+func parsePostgresPolicyID(s string) (postgresPolicyID, error) {
+	errTemplate := errors.New("ID of this resource type shall follow the template: " +
+		"{{.ProjectID}}/{{.BranchID}}/{{.Database}}/{{.Table}}/{{.Name}}",
+	)
+
+	r, err := parseSQLObjectID(s)
+	if err != nil {
+		return postgresPolicyID{}, errTemplate
+	}
+	table, name, ok := strings.Cut(r.Name, "/")
+	if !ok || r.ProjectID == "" || r.BranchID == "" || r.Database == "" || name == "" {
+		return postgresPolicyID{}, errTemplate
+	}
+	if _, _, err := parsePostgresTableName(table); err != nil {
+		return postgresPolicyID{}, err
+	}
+
+	r.Name = name
+	return postgresPolicyID{sqlObjectID: r, Table: table}, nil
+}
+
+func setResourceAttrsFromPostgresPolicyID(d *schema.ResourceData, r postgresPolicyID) {
+	setResourceAttrsFromSQLObjectID(d, r.sqlObjectID)
+	_ = d.Set("table", r.Table)
+}
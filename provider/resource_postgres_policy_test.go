@@ -0,0 +1,210 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func Test_newCreatePolicyStatement(t *testing.T) {
+	tests := []struct {
+		name       string
+		command    string
+		permissive bool
+		roles      []string
+		using      string
+		withCheck  string
+		want       string
+	}{
+		{
+			name:       "all roles",
+			command:    "ALL",
+			permissive: true,
+			using:      "user_id = auth.user_id()",
+			want:       `CREATE POLICY "foo" ON "public"."users" AS PERMISSIVE FOR ALL TO PUBLIC USING (user_id = auth.user_id())`,
+		},
+		{
+			name:      "restrictive with check",
+			command:   "INSERT",
+			roles:     []string{"anonymous", "authenticated"},
+			withCheck: "true",
+			want: `CREATE POLICY "foo" ON "public"."users" AS RESTRICTIVE FOR INSERT TO "anonymous", "authenticated" ` +
+				`WITH CHECK (true)`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newCreatePolicyStatement(
+				"foo", "public.users", tt.command, tt.permissive, tt.roles, tt.using, tt.withCheck,
+			); got != tt.want {
+				t.Errorf("newCreatePolicyStatement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_normalizePostgresExpression(t *testing.T) {
+	tests := []struct {
+		name      string
+		config    string
+		changed   string
+		wantEqual bool
+	}{
+		{
+			name:      "whitespaces and case",
+			config:    "tenant_id = current_setting('app.tenant')::uuid\n  AND NOT archived",
+			changed:   " tenant_id  =  CURRENT_SETTING('app.tenant')::UUID AND\tNOT archived ",
+			wantEqual: true,
+		},
+		{
+			name:    "parentheses",
+			config:  "(a OR b) AND c",
+			changed: "a OR (b AND c)",
+		},
+		{
+			name:    "type casts",
+			config:  "x::int > 0",
+			changed: "x::text > 0",
+		},
+		{
+			name:    "literal case",
+			config:  "role = 'Admin'",
+			changed: "role = 'admin'",
+		},
+		{
+			name:    "literal whitespaces",
+			config:  "role = 'foo bar'",
+			changed: "role = 'foo  bar'",
+		},
+		{
+			name:    "quoted identifier case",
+			config:  `"UserID" = auth.user_id()`,
+			changed: `"userid" = auth.user_id()`,
+		},
+		{
+			name:    "tokens",
+			config:  "NOT archived",
+			changed: "NOTarchived",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := normalizePostgresExpression(tt.config) == normalizePostgresExpression(tt.changed); got !=
+				tt.wantEqual {
+				t.Errorf("unexpected comparison result: want=%v, got=%v", tt.wantEqual, got)
+			}
+		})
+	}
+}
+
+func Test_parsePostgresPolicyID(t *testing.T) {
+	t.Run("shall parse the ID", func(t *testing.T) {
+		got, err := parsePostgresPolicyID("myproject/br-foo/neondb/public.users/owner")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		want := postgresPolicyID{
+			sqlObjectID: sqlObjectID{
+				complexID: complexID{ProjectID: "myproject", BranchID: "br-foo", Name: "owner"}, Database: "neondb",
+			},
+			Table: "public.users",
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected ID: want=%v, got=%v", want, got)
+		}
+		if got.toString() != "myproject/br-foo/neondb/public.users/owner" {
+			t.Errorf("unexpected ID: %s", got.toString())
+		}
+	})
+
+	for _, id := range []string{
+		"myproject/br-foo/neondb/users/owner",
+		"myproject/br-foo/neondb/public.users/",
+		"myproject/br-foo/neondb/public.users",
+	} {
+		t.Run("shall fail for "+id, func(t *testing.T) {
+			if _, err := parsePostgresPolicyID(id); err == nil {
+				t.Error("error expected")
+			}
+		})
+	}
+}
+
+func Test_resourcePostgresPolicy(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	meta := newTestSQLMeta(t)
+
+	execTestSQL(t, meta,
+		`CREATE ROLE policy_test_role`,
+		`CREATE TABLE public.policy_test (id int, user_id text)`,
+	)
+	t.Cleanup(func() {
+		execTestSQL(t, meta, `DROP TABLE IF EXISTS public.policy_test`, `DROP ROLE IF EXISTS policy_test_role`)
+	})
+
+	definition := resourcePostgresPolicy().TestResourceData()
+	_ = definition.Set("project_id", "myproject")
+	_ = definition.Set("branch_id", "br-foo")
+	_ = definition.Set("database", "postgres")
+	_ = definition.Set("name", "owner")
+	_ = definition.Set("table", "public.policy_test")
+	_ = definition.Set("using", "user_id = current_user")
+
+	if err := resourcePostgresPolicyCreate(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "myproject/br-foo/postgres/public.policy_test/owner"; definition.Id() != want {
+		t.Errorf("unexpected resource ID: want=%s, got=%s", want, definition.Id())
+	}
+	if v := definition.Get("using").(string); v != "user_id = current_user" {
+		t.Errorf("configured expression shall be kept, got: %s", v)
+	}
+	if v := definition.Get("using_deparsed").(string); v == "" {
+		t.Error("deparsed expression expected to be set")
+	}
+	if n := definition.Get("roles").(interface{ Len() int }).Len(); n != 0 {
+		t.Errorf("no roles expected, got %d", n)
+	}
+	if !definition.Get("enable_row_level_security").(bool) {
+		t.Error("row-level security expected to be enabled")
+	}
+
+	t.Run("shall keep the configured expression if it is not changed", func(t *testing.T) {
+		if err := resourcePostgresPolicyRead(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if v := definition.Get("using").(string); v != "user_id = current_user" {
+			t.Errorf("configured expression shall be kept, got: %s", v)
+		}
+	})
+
+	t.Run("shall detect the drift", func(t *testing.T) {
+		execTestSQL(t, meta,
+			`ALTER POLICY owner ON public.policy_test TO policy_test_role USING (id > 0)`,
+			`ALTER TABLE public.policy_test DISABLE ROW LEVEL SECURITY`,
+		)
+		if err := resourcePostgresPolicyRead(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got := getStringSet(definition.Get("roles")); !reflect.DeepEqual(got, []string{"policy_test_role"}) {
+			t.Errorf("unexpected roles: %v", got)
+		}
+		if v := definition.Get("using").(string); v != "(id > 0)" {
+			t.Errorf("unexpected expression: %s", v)
+		}
+		if v := definition.Get("using_deparsed").(string); v != "(id > 0)" {
+			t.Errorf("unexpected deparsed expression: %s", v)
+		}
+		if definition.Get("enable_row_level_security").(bool) {
+			t.Error("row-level security expected to be disabled")
+		}
+	})
+}
@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
+)
+
+var postgresPublicationOperations = []string{"insert", "update", "delete", "truncate"}
+
+func resourcePostgresPublication() *schema.Resource {
+	return &schema.Resource{
+		Description: `Postgres publication in the database of the branch.
+See details: https://neon.com/docs/guides/logical-replication-concepts
+
+The logical replication must be enabled for the project, see the attribute enable_logical_replication
+of the resource ` + "`neon_project`" + `.
+The resource connects to the database via the branch's read-write endpoint, hence the endpoint must exist.
+`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePostgresPublicationImport,
+		},
+		CreateContext: resourcePostgresPublicationCreateRetry,
+		ReadContext:   resourcePostgresPublicationReadRetry,
+		UpdateContext: resourcePostgresPublicationUpdateRetry,
+		DeleteContext: resourcePostgresPublicationDeleteRetry,
+		Schema: newSchemaSQLTarget(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Publication name.",
+			},
+			"all_tables": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				ForceNew:      true,
+				Default:       false,
+				ConflictsWith: []string{"tables"},
+				Description:   "Publish the changes of all tables in the database, including the tables created in the future.",
+			},
+			"tables": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				ConflictsWith: []string{"all_tables"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+						if _, _, err := parsePostgresTableName(i.(string)); err != nil {
+							errs = append(errs, err)
+						}
+						return
+					},
+				},
+				Description: `Tables to publish the changes of, every table is defined as "schema.table", e.g. "public.users".`,
+			},
+			"publish": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+					ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+						if v := i.(string); !slices.Contains(postgresPublicationOperations, v) {
+							errs = append(errs, fmt.Errorf("%s is not supported value for %s", v, s))
+						}
+						return
+					},
+				},
+				Description: `Operations to publish. Allowed values: "` + strings.Join(postgresPublicationOperations, `", "`) +
+					`". All operations are published if not set.`,
+			},
+		}),
+	}
+}
+
+// parsePostgresTableName parses the table name defined as schema.table.
+func parsePostgresTableName(s string) (schemaName, tableName string, err error) {
+	els := strings.SplitN(s, ".", 2)
+	if len(els) != 2 || els[0] == "" || els[1] == "" {
+		return "", "", fmt.Errorf(`table %s shall be defined as "schema.table"`, s)
+	}
+	return els[0], els[1], nil
+}
+
+func quotePostgresTableNames(tables []string) string {
+	var o = make([]string, len(tables))
+	for i, v := range tables {
+		schemaName, tableName, _ := parsePostgresTableName(v)
+		o[i] = quoteIdentifier(schemaName) + "." + quoteIdentifier(tableName)
+	}
+	return strings.Join(o, ", ")
+}
+
+func newCreatePublicationStatement(name string, allTables bool, tables, publish []string) string {
+	var b strings.Builder
+	b.WriteString("CREATE PUBLICATION " + quoteIdentifier(name))
+	switch {
+	case allTables:
+		b.WriteString(" FOR ALL TABLES")
+	case len(tables) > 0:
+		b.WriteString(" FOR TABLE " + quotePostgresTableNames(tables))
+	}
+	if len(publish) > 0 {
+		b.WriteString(" WITH (publish = " + quoteLiteral(strings.Join(publish, ", ")) + ")")
+	}
+	return b.String()
+}
+
+func resourcePostgresPublicationCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresPublicationCreate, ctx, d, meta)
+}
+
+func resourcePostgresPublicationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	t := newSQLTarget(d)
+	r := newSQLObjectID(t, d.Get("name").(string))
+	tflog.Trace(ctx, "create Postgres Publication", map[string]interface{}{"id": r.toString()})
+
+	q := newCreatePublicationStatement(
+		r.Name, d.Get("all_tables").(bool), getStringSet(d.Get("tables")), getStringSet(d.Get("publish")),
+	)
+	if err := withSQLConn(ctx, meta, t, func(conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx, q)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(r.toString())
+	return resourcePostgresPublicationRead(ctx, d, meta)
+}
+
+func resourcePostgresPublicationReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLRead(resourcePostgresPublicationRead, ctx, d, meta)
+}
+
+func resourcePostgresPublicationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "read Postgres Publication", map[string]interface{}{"id": d.Id()})
+
+	name := d.Get("name").(string)
+
+	var (
+		allTables bool
+		flags     = make([]bool, len(postgresPublicationOperations))
+		tables    []string
+	)
+	err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		if err := conn.QueryRow(ctx,
+			`SELECT puballtables, pubinsert, pubupdate, pubdelete, pubtruncate
+FROM pg_catalog.pg_publication
+WHERE pubname = $1`,
+			name,
+		).Scan(&allTables, &flags[0], &flags[1], &flags[2], &flags[3]); err != nil {
+			return err
+		}
+
+		if allTables {
+			return nil
+		}
+
+		rows, err := conn.Query(ctx,
+			`SELECT schemaname || '.' || tablename
+FROM pg_catalog.pg_publication_tables
+WHERE pubname = $1
+ORDER BY 1`,
+			name,
+		)
+		if err != nil {
+			return err
+		}
+		tables, err = pgx.CollectRows(rows, pgx.RowTo[string])
+		return err
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		tflog.Debug(ctx, "Postgres publication not found, removing from state", map[string]interface{}{"id": d.Id()})
+		d.SetId("")
+		return nil
+	case err != nil:
+		return err
+	}
+
+	var publish []string
+	for i, v := range postgresPublicationOperations {
+		if flags[i] {
+			publish = append(publish, v)
+		}
+	}
+
+	if err := d.Set("all_tables", allTables); err != nil {
+		return err
+	}
+	if err := d.Set("tables", tables); err != nil {
+		return err
+	}
+	return d.Set("publish", publish)
+}
+
+func resourcePostgresPublicationUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresPublicationUpdate, ctx, d, meta)
+}
+
+func resourcePostgresPublicationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "update Postgres Publication", map[string]interface{}{"id": d.Id()})
+
+	name := quoteIdentifier(d.Get("name").(string))
+
+	var statements []string
+	if d.HasChange("tables") {
+		o, n := d.GetChange("tables")
+		oldTables, newTables := getStringSet(o), getStringSet(n)
+		if v := difference(oldTables, newTables); len(v) > 0 {
+			statements = append(statements, "ALTER PUBLICATION "+name+" DROP TABLE "+quotePostgresTableNames(v))
+		}
+		if v := difference(newTables, oldTables); len(v) > 0 {
+			statements = append(statements, "ALTER PUBLICATION "+name+" ADD TABLE "+quotePostgresTableNames(v))
+		}
+	}
+	if v := getStringSet(d.Get("publish")); d.HasChange("publish") && len(v) > 0 {
+		statements = append(statements,
+			"ALTER PUBLICATION "+name+" SET (publish = "+quoteLiteral(strings.Join(v, ", "))+")",
+		)
+	}
+
+	if len(statements) > 0 {
+		if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+			for _, q := range statements {
+				if _, err := conn.Exec(ctx, q); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return resourcePostgresPublicationRead(ctx, d, meta)
+}
+
+func resourcePostgresPublicationDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLDelete(resourcePostgresPublicationDelete, ctx, d, meta)
+}
+
+func resourcePostgresPublicationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "delete Postgres Publication", map[string]interface{}{"id": d.Id()})
+
+	q := "DROP PUBLICATION IF EXISTS " + quoteIdentifier(d.Get("name").(string))
+	if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx, q)
+		return err
+	}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourcePostgresPublicationImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import Postgres Publication")
+
+	r, err := parseSQLObjectID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	setResourceAttrsFromSQLObjectID(d, r)
+
+	if diags := projectReadiness.Retry(resourcePostgresPublicationRead, ctx, d, meta); diags.HasError() {
+		setResourceAttrsFromSQLObjectID(d, sqlObjectID{})
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("no Postgres publication found")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,118 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func Test_newCreatePublicationStatement(t *testing.T) {
+	tests := []struct {
+		name      string
+		allTables bool
+		tables    []string
+		publish   []string
+		want      string
+	}{
+		{
+			name: "no tables",
+			want: `CREATE PUBLICATION "foo"`,
+		},
+		{
+			name:      "all tables",
+			allTables: true,
+			publish:   []string{"insert", "update"},
+			want:      `CREATE PUBLICATION "foo" FOR ALL TABLES WITH (publish = 'insert, update')`,
+		},
+		{
+			name:   "tables",
+			tables: []string{"public.users", "app.orders"},
+			want:   `CREATE PUBLICATION "foo" FOR TABLE "public"."users", "app"."orders"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newCreatePublicationStatement("foo", tt.allTables, tt.tables, tt.publish); got != tt.want {
+				t.Errorf("newCreatePublicationStatement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parsePostgresTableName(t *testing.T) {
+	schemaName, tableName, err := parsePostgresTableName("public.users.archive")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if schemaName != "public" || tableName != "users.archive" {
+		t.Errorf("unexpected table name: schema=%s, table=%s", schemaName, tableName)
+	}
+
+	for _, v := range []string{"users", ".users", "public."} {
+		if _, _, err := parsePostgresTableName(v); err == nil {
+			t.Errorf("error expected for %s", v)
+		}
+	}
+}
+
+func Test_resourcePostgresPublication(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	meta := newTestSQLMeta(t)
+
+	execTestSQL(t, meta,
+		`CREATE SCHEMA publication_test`,
+		`CREATE TABLE publication_test.foo (id int PRIMARY KEY)`,
+		`CREATE TABLE publication_test.bar (id int PRIMARY KEY)`,
+	)
+	t.Cleanup(func() {
+		execTestSQL(t, meta, `DROP PUBLICATION IF EXISTS publication_test`, `DROP SCHEMA publication_test CASCADE`)
+	})
+
+	definition := resourcePostgresPublication().TestResourceData()
+	_ = definition.Set("project_id", "myproject")
+	_ = definition.Set("branch_id", "br-foo")
+	_ = definition.Set("database", "postgres")
+	_ = definition.Set("name", "publication_test")
+	_ = definition.Set("tables", []string{"publication_test.foo"})
+	_ = definition.Set("publish", []string{"insert", "update"})
+
+	if err := resourcePostgresPublicationCreate(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "myproject/br-foo/postgres/publication_test"; definition.Id() != want {
+		t.Errorf("unexpected resource ID: want=%s, got=%s", want, definition.Id())
+	}
+	if got, want := getStringSet(definition.Get("publish")), []string{"insert", "update"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected publish: want=%v, got=%v", want, got)
+	}
+
+	// the drift shall be detected
+	execTestSQL(t, meta, `ALTER PUBLICATION publication_test ADD TABLE publication_test.bar`)
+	if err := resourcePostgresPublicationRead(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got, want := getStringSet(definition.Get("tables")),
+		[]string{"publication_test.bar", "publication_test.foo"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected tables: want=%v, got=%v", want, got)
+	}
+
+	if err := resourcePostgresPublicationDelete(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	definition.SetId("myproject/br-foo/postgres/publication_test")
+	if err := resourcePostgresPublicationRead(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if definition.Id() != "" {
+		t.Error("deleted publication expected to be removed from the state")
+	}
+}
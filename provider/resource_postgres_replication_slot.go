@@ -0,0 +1,168 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
+)
+
+const defaultReplicationSlotPlugin = "pgoutput"
+
+func resourcePostgresReplicationSlot() *schema.Resource {
+	return &schema.Resource{
+		Description: `Postgres logical replication slot in the database of the branch.
+See details: https://neon.com/docs/guides/logical-replication-manage
+
+The logical replication must be enabled for the project, see the attribute enable_logical_replication
+of the resource ` + "`neon_project`" + `.
+The resource connects to the database via the branch's read-write endpoint, hence the endpoint must exist.
+The active slot cannot be dropped, stop the replication before the resource's deletion.
+`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePostgresReplicationSlotImport,
+		},
+		CreateContext: resourcePostgresReplicationSlotCreateRetry,
+		ReadContext:   resourcePostgresReplicationSlotReadRetry,
+		UpdateContext: resourcePostgresReplicationSlotUpdateRetry,
+		DeleteContext: resourcePostgresReplicationSlotDeleteRetry,
+		Schema: newSchemaSQLTarget(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Replication slot name.",
+			},
+			"plugin": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     defaultReplicationSlotPlugin,
+				Description: "Output plugin, e.g. pgoutput, wal2json.",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Flag showing if the slot is currently used.",
+			},
+		}),
+	}
+}
+
+func resourcePostgresReplicationSlotCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresReplicationSlotCreate, ctx, d, meta)
+}
+
+func resourcePostgresReplicationSlotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	t := newSQLTarget(d)
+	r := newSQLObjectID(t, d.Get("name").(string))
+	tflog.Trace(ctx, "create Postgres Replication Slot", map[string]interface{}{"id": r.toString()})
+
+	if err := withSQLConn(ctx, meta, t, func(conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx, `SELECT pg_catalog.pg_create_logical_replication_slot($1, $2)`,
+			r.Name, d.Get("plugin").(string),
+		)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(r.toString())
+	return resourcePostgresReplicationSlotRead(ctx, d, meta)
+}
+
+func resourcePostgresReplicationSlotReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLRead(resourcePostgresReplicationSlotRead, ctx, d, meta)
+}
+
+func resourcePostgresReplicationSlotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "read Postgres Replication Slot", map[string]interface{}{"id": d.Id()})
+
+	var (
+		plugin string
+		active bool
+	)
+	err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		return conn.QueryRow(ctx,
+			`SELECT plugin::text, active
+FROM pg_catalog.pg_replication_slots
+WHERE slot_name = $1 AND slot_type = 'logical' AND database = current_database()`,
+			d.Get("name").(string),
+		).Scan(&plugin, &active)
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		tflog.Debug(ctx, "Postgres replication slot not found, removing from state",
+			map[string]interface{}{"id": d.Id()})
+		d.SetId("")
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if err := d.Set("plugin", plugin); err != nil {
+		return err
+	}
+	return d.Set("active", active)
+}
+
+func resourcePostgresReplicationSlotUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresReplicationSlotUpdate, ctx, d, meta)
+}
+
+// resourcePostgresReplicationSlotUpdate reads the slot, because only the role to connect to the database as can change.
+func resourcePostgresReplicationSlotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "update Postgres Replication Slot", map[string]interface{}{"id": d.Id()})
+	return resourcePostgresReplicationSlotRead(ctx, d, meta)
+}
+
+func resourcePostgresReplicationSlotDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLDelete(resourcePostgresReplicationSlotDelete, ctx, d, meta)
+}
+
+func resourcePostgresReplicationSlotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "delete Postgres Replication Slot", map[string]interface{}{"id": d.Id()})
+
+	if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx,
+			`SELECT pg_catalog.pg_drop_replication_slot(slot_name)
+FROM pg_catalog.pg_replication_slots
+WHERE slot_name = $1`,
+			d.Get("name").(string),
+		)
+		return err
+	}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourcePostgresReplicationSlotImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import Postgres Replication Slot")
+
+	r, err := parseSQLObjectID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	setResourceAttrsFromSQLObjectID(d, r)
+
+	if diags := projectReadiness.Retry(resourcePostgresReplicationSlotRead, ctx, d, meta); diags.HasError() {
+		setResourceAttrsFromSQLObjectID(d, sqlObjectID{})
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("no Postgres replication slot found")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,63 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func Test_resourcePostgresReplicationSlot(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	meta := newTestSQLMeta(t)
+
+	var walLevel string
+	if err := withSQLConn(context.TODO(), meta, sqlTarget{}, func(conn *pgx.Conn) error {
+		return conn.QueryRow(context.TODO(), `SHOW wal_level`).Scan(&walLevel)
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if walLevel != "logical" {
+		t.Skip("wal_level must be set to logical")
+	}
+
+	definition := resourcePostgresReplicationSlot().TestResourceData()
+	_ = definition.Set("project_id", "myproject")
+	_ = definition.Set("branch_id", "br-foo")
+	_ = definition.Set("database", "postgres")
+	_ = definition.Set("name", "replication_slot_test")
+	_ = definition.Set("plugin", defaultReplicationSlotPlugin)
+
+	if err := resourcePostgresReplicationSlotCreate(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = resourcePostgresReplicationSlotDelete(context.TODO(), definition, meta)
+	})
+
+	if want := "myproject/br-foo/postgres/replication_slot_test"; definition.Id() != want {
+		t.Errorf("unexpected resource ID: want=%s, got=%s", want, definition.Id())
+	}
+	if definition.Get("active").(bool) {
+		t.Error("slot is not expected to be active")
+	}
+
+	if err := resourcePostgresReplicationSlotDelete(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	definition.SetId("myproject/br-foo/postgres/replication_slot_test")
+	if err := resourcePostgresReplicationSlotRead(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if definition.Id() != "" {
+		t.Error("deleted slot expected to be removed from the state")
+	}
+}
@@ -0,0 +1,177 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
+)
+
+func resourcePostgresSchema() *schema.Resource {
+	return &schema.Resource{
+		Description: `Postgres schema in the database of the branch. See details: https://www.postgresql.org/docs/current/ddl-schemas.html
+
+The resource connects to the database via the branch's read-write endpoint, hence the endpoint must exist.
+`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePostgresSchemaImport,
+		},
+		CreateContext: resourcePostgresSchemaCreateRetry,
+		ReadContext:   resourcePostgresSchemaReadRetry,
+		UpdateContext: resourcePostgresSchemaUpdateRetry,
+		DeleteContext: resourcePostgresSchemaDeleteRetry,
+		Schema: newSchemaSQLTarget(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Schema name.",
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Name of the role which owns the schema. The role used to connect to the database is the owner if not set.",
+			},
+			"drop_cascade": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Drop the objects contained in the schema upon deletion.",
+			},
+		}),
+	}
+}
+
+func newCreateSchemaStatement(name, owner string) string {
+	s := "CREATE SCHEMA " + quoteIdentifier(name)
+	if owner != "" {
+		s += " AUTHORIZATION " + quoteIdentifier(owner)
+	}
+	return s
+}
+
+func newDropSchemaStatement(name string, cascade bool) string {
+	s := "DROP SCHEMA IF EXISTS " + quoteIdentifier(name)
+	if cascade {
+		s += " CASCADE"
+	}
+	return s
+}
+
+func resourcePostgresSchemaCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresSchemaCreate, ctx, d, meta)
+}
+
+func resourcePostgresSchemaCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	t := newSQLTarget(d)
+	r := newSQLObjectID(t, d.Get("name").(string))
+	tflog.Trace(ctx, "create Postgres Schema", map[string]interface{}{"id": r.toString()})
+
+	q := newCreateSchemaStatement(r.Name, d.Get("owner").(string))
+	if err := withSQLConn(ctx, meta, t, func(conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx, q)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(r.toString())
+	return resourcePostgresSchemaRead(ctx, d, meta)
+}
+
+func resourcePostgresSchemaReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLRead(resourcePostgresSchemaRead, ctx, d, meta)
+}
+
+func resourcePostgresSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "read Postgres Schema", map[string]interface{}{"id": d.Id()})
+
+	var owner string
+	err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		return conn.QueryRow(ctx,
+			`SELECT pg_catalog.pg_get_userbyid(n.nspowner)::text
+FROM pg_catalog.pg_namespace n
+WHERE n.nspname = $1`,
+			d.Get("name").(string),
+		).Scan(&owner)
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		tflog.Debug(ctx, "Postgres schema not found, removing from state", map[string]interface{}{"id": d.Id()})
+		d.SetId("")
+		return nil
+	case err != nil:
+		return err
+	}
+
+	return d.Set("owner", owner)
+}
+
+func resourcePostgresSchemaUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresSchemaUpdate, ctx, d, meta)
+}
+
+func resourcePostgresSchemaUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "update Postgres Schema", map[string]interface{}{"id": d.Id()})
+
+	if v := d.Get("owner").(string); d.HasChange("owner") && v != "" {
+		q := "ALTER SCHEMA " + quoteIdentifier(d.Get("name").(string)) + " OWNER TO " + quoteIdentifier(v)
+		if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+			_, err := conn.Exec(ctx, q)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	return resourcePostgresSchemaRead(ctx, d, meta)
+}
+
+func resourcePostgresSchemaDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLDelete(resourcePostgresSchemaDelete, ctx, d, meta)
+}
+
+func resourcePostgresSchemaDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "delete Postgres Schema", map[string]interface{}{"id": d.Id()})
+
+	q := newDropSchemaStatement(d.Get("name").(string), d.Get("drop_cascade").(bool))
+	if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx, q)
+		return err
+	}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourcePostgresSchemaImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import Postgres Schema")
+
+	r, err := parseSQLObjectID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	setResourceAttrsFromSQLObjectID(d, r)
+	_ = d.Set("drop_cascade", false)
+
+	if diags := projectReadiness.Retry(resourcePostgresSchemaRead, ctx, d, meta); diags.HasError() {
+		setResourceAttrsFromSQLObjectID(d, sqlObjectID{})
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("no Postgres schema found")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,113 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+)
+
+func Test_newCreateSchemaStatement(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		owner  string
+		want   string
+	}{
+		{
+			name:   "default owner",
+			schema: "tenant_foo",
+			want:   `CREATE SCHEMA "tenant_foo"`,
+		},
+		{
+			name:   "with owner",
+			schema: "tenant_foo",
+			owner:  "foo",
+			want:   `CREATE SCHEMA "tenant_foo" AUTHORIZATION "foo"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newCreateSchemaStatement(tt.schema, tt.owner); got != tt.want {
+				t.Errorf("newCreateSchemaStatement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_resourcePostgresSchema(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	meta := newTestSQLMeta(t)
+
+	execTestSQL(t, meta, `CREATE ROLE schema_test_owner`)
+	t.Cleanup(func() {
+		execTestSQL(t, meta, `DROP SCHEMA IF EXISTS schema_test CASCADE`, `DROP ROLE schema_test_owner`)
+	})
+
+	definition := resourcePostgresSchema().TestResourceData()
+	_ = definition.Set("project_id", "myproject")
+	_ = definition.Set("branch_id", "br-foo")
+	_ = definition.Set("database", "postgres")
+	_ = definition.Set("name", "schema_test")
+	_ = definition.Set("drop_cascade", true)
+
+	if err := resourcePostgresSchemaCreate(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "myproject/br-foo/postgres/schema_test"; definition.Id() != want {
+		t.Errorf("unexpected resource ID: want=%s, got=%s", want, definition.Id())
+	}
+	if v := definition.Get("owner").(string); v == "" {
+		t.Error("owner expected to be set")
+	}
+
+	execTestSQL(t, meta, `CREATE TABLE schema_test.foo (id int)`)
+
+	imported := resourcePostgresSchema().TestResourceData()
+	imported.SetId(definition.Id())
+	if _, err := resourcePostgresSchemaImport(context.TODO(), imported, meta); err != nil {
+		t.Fatalf("unexpected import error: %v", err)
+	}
+	if imported.Get("owner").(string) != definition.Get("owner").(string) {
+		t.Errorf("unexpected imported owner: %s", imported.Get("owner"))
+	}
+
+	_ = definition.Set("owner", "schema_test_owner")
+	if err := resourcePostgresSchemaUpdate(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var owner string
+	if err := withSQLConn(context.TODO(), meta, sqlTarget{}, func(conn *pgx.Conn) error {
+		return conn.QueryRow(context.TODO(),
+			`SELECT nspowner::regrole::text FROM pg_catalog.pg_namespace WHERE nspname = 'schema_test'`,
+		).Scan(&owner)
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if owner != "schema_test_owner" {
+		t.Errorf("unexpected owner in the database: want=schema_test_owner, got=%s", owner)
+	}
+	if v := definition.Get("owner").(string); v != "schema_test_owner" {
+		t.Errorf("unexpected owner: want=schema_test_owner, got=%s", v)
+	}
+
+	if err := resourcePostgresSchemaDelete(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	definition.SetId("myproject/br-foo/postgres/schema_test")
+	if err := resourcePostgresSchemaRead(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if definition.Id() != "" {
+		t.Error("deleted schema expected to be removed from the state")
+	}
+}
@@ -0,0 +1,303 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
+)
+
+func resourcePostgresSubscription() *schema.Resource {
+	return &schema.Resource{
+		Description: `Postgres subscription in the database of the branch to replicate the data from the publication
+in the database of another Neon branch. See details: https://neon.com/docs/guides/logical-replication-neon-to-neon
+
+The logical replication must be enabled for the source project, see the attribute enable_logical_replication
+of the resource ` + "`neon_project`" + `.
+The subscription connects to the source database via the source branch's read-write endpoint
+using the password of the source role read from the Neon API.
+The resource connects to the database via the branch's read-write endpoint, hence the endpoint must exist.
+`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourcePostgresSubscriptionImport,
+		},
+		CreateContext: resourcePostgresSubscriptionCreateRetry,
+		ReadContext:   resourcePostgresSubscriptionReadRetry,
+		UpdateContext: resourcePostgresSubscriptionUpdateRetry,
+		DeleteContext: resourcePostgresSubscriptionDeleteRetry,
+		Schema: newSchemaSQLTarget(map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Subscription name.",
+			},
+			"source_project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the project with the publication.",
+			},
+			"source_branch_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "ID of the branch with the publication.",
+			},
+			"source_database": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the database with the publication.",
+			},
+			"source_role_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Name of the role to connect to the source database as. The database owner is used if not set.",
+			},
+			"publications": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the publications to subscribe to.",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Flag to enable the replication.",
+			},
+			"create_slot": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+				Description: `Create the replication slot in the source database.
+Set to false to use the slot created beforehand, e.g. with the resource ` + "`neon_postgres_replication_slot`" + `.
+The slot created beforehand is kept when the subscription is deleted.`,
+			},
+			"slot_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of the replication slot in the source database. The subscription name is used if not set.",
+			},
+		}),
+	}
+}
+
+func newSourceSQLTarget(d *schema.ResourceData) sqlTarget {
+	return sqlTarget{
+		ProjectID: d.Get("source_project_id").(string),
+		BranchID:  d.Get("source_branch_id").(string),
+		Database:  d.Get("source_database").(string),
+		Role:      d.Get("source_role_name").(string),
+	}
+}
+
+func quotePostgresIdentifiers(v []string) string {
+	var o = make([]string, len(v))
+	for i, el := range v {
+		o[i] = quoteIdentifier(el)
+	}
+	return strings.Join(o, ", ")
+}
+
+func newCreateSubscriptionStatement(name, connString string, publications []string, enabled, createSlot bool,
+	slotName string) string {
+	var b strings.Builder
+	b.WriteString("CREATE SUBSCRIPTION " + quoteIdentifier(name))
+	b.WriteString(" CONNECTION " + quoteLiteral(connString))
+	b.WriteString(" PUBLICATION " + quotePostgresIdentifiers(publications))
+	b.WriteString(" WITH (enabled = " + strconv.FormatBool(enabled))
+	b.WriteString(", create_slot = " + strconv.FormatBool(createSlot))
+	if slotName != "" {
+		b.WriteString(", slot_name = " + quoteLiteral(slotName))
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+func resourcePostgresSubscriptionCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresSubscriptionCreate, ctx, d, meta)
+}
+
+func resourcePostgresSubscriptionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	t := newSQLTarget(d)
+	r := newSQLObjectID(t, d.Get("name").(string))
+	tflog.Trace(ctx, "create Postgres Subscription", map[string]interface{}{"id": r.toString()})
+
+	connString, err := newSQLConnString(meta, newSourceSQLTarget(d))
+	if err != nil {
+		return err
+	}
+
+	q := newCreateSubscriptionStatement(r.Name, connString, getStringSet(d.Get("publications")),
+		d.Get("enabled").(bool), d.Get("create_slot").(bool), d.Get("slot_name").(string),
+	)
+	if err := withSQLConn(ctx, meta, t, func(conn *pgx.Conn) error {
+		_, err := conn.Exec(ctx, q)
+		return err
+	}); err != nil {
+		return err
+	}
+
+	d.SetId(r.toString())
+	return resourcePostgresSubscriptionRead(ctx, d, meta)
+}
+
+func resourcePostgresSubscriptionReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLRead(resourcePostgresSubscriptionRead, ctx, d, meta)
+}
+
+func resourcePostgresSubscriptionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "read Postgres Subscription", map[string]interface{}{"id": d.Id()})
+
+	var (
+		enabled      bool
+		slotName     string
+		publications []string
+	)
+	err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		return conn.QueryRow(ctx,
+			`SELECT s.subenabled, COALESCE(s.subslotname::text, ''), s.subpublications
+FROM pg_catalog.pg_subscription s
+JOIN pg_catalog.pg_database db ON db.oid = s.subdbid
+WHERE s.subname = $1 AND db.datname = current_database()`,
+			d.Get("name").(string),
+		).Scan(&enabled, &slotName, &publications)
+	})
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		tflog.Debug(ctx, "Postgres subscription not found, removing from state", map[string]interface{}{"id": d.Id()})
+		d.SetId("")
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if err := d.Set("enabled", enabled); err != nil {
+		return err
+	}
+	if err := d.Set("slot_name", slotName); err != nil {
+		return err
+	}
+	return d.Set("publications", publications)
+}
+
+func resourcePostgresSubscriptionUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourcePostgresSubscriptionUpdate, ctx, d, meta)
+}
+
+func resourcePostgresSubscriptionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "update Postgres Subscription", map[string]interface{}{"id": d.Id()})
+
+	name := quoteIdentifier(d.Get("name").(string))
+
+	var statements []string
+	if d.HasChanges("source_project_id", "source_branch_id", "source_database", "source_role_name") {
+		connString, err := newSQLConnString(meta, newSourceSQLTarget(d))
+		if err != nil {
+			return err
+		}
+		statements = append(statements, "ALTER SUBSCRIPTION "+name+" CONNECTION "+quoteLiteral(connString))
+	}
+	if d.HasChange("publications") {
+		statements = append(statements,
+			"ALTER SUBSCRIPTION "+name+" SET PUBLICATION "+quotePostgresIdentifiers(getStringSet(d.Get("publications"))),
+		)
+	}
+	if d.HasChange("enabled") {
+		if d.Get("enabled").(bool) {
+			statements = append(statements, "ALTER SUBSCRIPTION "+name+" ENABLE")
+		} else {
+			statements = append(statements, "ALTER SUBSCRIPTION "+name+" DISABLE")
+		}
+	}
+
+	if len(statements) > 0 {
+		if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+			for _, q := range statements {
+				if _, err := conn.Exec(ctx, q); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return resourcePostgresSubscriptionRead(ctx, d, meta)
+}
+
+func resourcePostgresSubscriptionDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLDelete(resourcePostgresSubscriptionDelete, ctx, d, meta)
+}
+
+func resourcePostgresSubscriptionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "delete Postgres Subscription", map[string]interface{}{"id": d.Id()})
+
+	name := quoteIdentifier(d.Get("name").(string))
+	if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		// the slot created beforehand is kept, hence it is detached before the subscription is dropped
+		if !d.Get("create_slot").(bool) {
+			var exists bool
+			if err := conn.QueryRow(ctx,
+				`SELECT EXISTS (SELECT 1 FROM pg_catalog.pg_subscription s
+JOIN pg_catalog.pg_database db ON db.oid = s.subdbid
+WHERE s.subname = $1 AND db.datname = current_database())`,
+				d.Get("name").(string),
+			).Scan(&exists); err != nil {
+				return err
+			}
+			if exists {
+				for _, q := range []string{
+					"ALTER SUBSCRIPTION " + name + " DISABLE",
+					"ALTER SUBSCRIPTION " + name + " SET (slot_name = NONE)",
+				} {
+					if _, err := conn.Exec(ctx, q); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		_, err := conn.Exec(ctx, "DROP SUBSCRIPTION IF EXISTS "+name)
+		return err
+	}); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourcePostgresSubscriptionImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import Postgres Subscription")
+
+	r, err := parseSQLObjectID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	setResourceAttrsFromSQLObjectID(d, r)
+	_ = d.Set("create_slot", true)
+
+	if diags := projectReadiness.Retry(resourcePostgresSubscriptionRead, ctx, d, meta); diags.HasError() {
+		setResourceAttrsFromSQLObjectID(d, sqlObjectID{})
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		return nil, errors.New("no Postgres subscription found")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -63,6 +63,11 @@ func resourceProject() *schema.Resource {
 	return &schema.Resource{
 		Description: `Neon Project.
 
+The region and the autoscaling limits of the default endpoint are verified against the Neon API when planning.
+The autoscaling limits are not verified for the organization's project because the Neon API does not report
+the organization's plan limits. The history retention and the allowed IPs are not verified against the plan
+because the Neon API does not report the respective plan limits.
+
 See details: https://neon.tech/docs/get-started-with-neon/setting-up-a-project/
 API: https://api-docs.neon.tech/reference/createproject`,
 		SchemaVersion: 11,
@@ -73,6 +78,7 @@ API: https://api-docs.neon.tech/reference/createproject`,
 		ReadContext:   resourceProjectReadRetry,
 		UpdateContext: resourceProjectUpdateRetry,
 		DeleteContext: resourceProjectDeleteRetry,
+		CustomizeDiff: resourceProjectCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:        schema.TypeString,
@@ -107,7 +113,14 @@ API: https://api-docs.neon.tech/reference/createproject`,
 				Optional:    true,
 				Computed:    true,
 				ForceNew:    true,
-				Description: "Postgres version",
+				Description: "Postgres version. Supported versions: 14, 15, 16, 17, 18.",
+				// the versions supported by the Neon API, see neon.PgVersion
+				ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+					if v := i.(int); v < 14 || v > 18 {
+						errs = append(errs, fmt.Errorf("%s %d is not supported", s, v))
+					}
+					return
+				},
 			},
 			"store_password": newStoreProjectPasswordDefault(),
 			"history_retention_seconds": {
@@ -116,7 +129,8 @@ API: https://api-docs.neon.tech/reference/createproject`,
 				Default:      providerDefaultHistoryRetentionSeconds,
 				ValidateFunc: intValidationNotNegative,
 				Description: `The number of seconds to retain the point-in-time restore (PITR) backup history for this project.
-Default: 1 day, see https://neon.tech/docs/reference/glossary#point-in-time-restore.`,
+Default: 1 day, see https://neon.tech/docs/reference/glossary#point-in-time-restore.
+It caps how far back ` + "`parent_timestamp`" + ` of ` + "`neon_branch`" + `, and ` + "`timestamp`" + ` of ` + "`neon_snapshot`" + ` can reach.`,
 			},
 			"compute_provisioner": {
 				Type:     schema.TypeString,
@@ -0,0 +1,216 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func resourceProjectPermissions() *schema.Resource {
+	return &schema.Resource{
+		SchemaVersion: 1,
+		Description: `Authoritative set of the project's access permissions.
+The permissions granted outside of the resource are revoked, and the permissions revoked outside of the resource are granted back.
+
+~>**WARNING** The resource shall not be used together with the resource ` + "`neon_project_permission`" + ` for the same project.
+`,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceProjectPermissionsImport,
+		},
+		CreateContext: resourceProjectPermissionsCreateRetry,
+		ReadContext:   resourceProjectPermissionsReadRetry,
+		UpdateContext: resourceProjectPermissionsUpdateRetry,
+		DeleteContext: resourceProjectPermissionsDeleteRetry,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Project ID.",
+			},
+			"grantees": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         hashEmail,
+				Description: "Emails of the users whom to grant project permission. The emails are case-insensitive.",
+			},
+		},
+	}
+}
+
+func resourceProjectPermissionsCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceProjectPermissionsCreate, ctx, d, meta)
+}
+
+func resourceProjectPermissionsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	projectID := d.Get("project_id").(string)
+	if err := convergeProjectPermissions(ctx, meta.(sdkProject), projectID, getGrantees(d)); err != nil {
+		return err
+	}
+	d.SetId(projectID)
+	return resourceProjectPermissionsRead(ctx, d, meta)
+}
+
+func resourceProjectPermissionsUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceProjectPermissionsUpdate, ctx, d, meta)
+}
+
+func resourceProjectPermissionsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	if err := convergeProjectPermissions(ctx, meta.(sdkProject), d.Id(), getGrantees(d)); err != nil {
+		return err
+	}
+	return resourceProjectPermissionsRead(ctx, d, meta)
+}
+
+func resourceProjectPermissionsReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.RetryWithFallback(resourceProjectPermissionsRead, ctx, d, meta, map[int]FallbackFn{
+		http.StatusNotFound: func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			tflog.Debug(ctx, "project not found, removing project permissions from state",
+				map[string]interface{}{"project_id": d.Id()})
+			d.SetId("")
+			return nil
+		}})
+}
+
+func resourceProjectPermissionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	projectID := d.Id()
+	tflog.Trace(ctx, "list project permissions", map[string]interface{}{"projectID": projectID})
+
+	permissions, err := listActiveProjectPermissions(meta.(sdkProject), projectID)
+	if err != nil {
+		return err
+	}
+
+	// the grantees' emails are kept as defined in the state if they differ from the API's values only by case
+	var defined = make(map[string]string)
+	for _, email := range getGrantees(d) {
+		defined[strings.ToLower(email)] = email
+	}
+
+	var grantees = make([]string, len(permissions))
+	for i, permission := range permissions {
+		grantees[i] = permission.GrantedToEmail
+		if v, ok := defined[strings.ToLower(permission.GrantedToEmail)]; ok {
+			grantees[i] = v
+		}
+	}
+
+	if err := d.Set("project_id", projectID); err != nil {
+		return err
+	}
+	return d.Set("grantees", grantees)
+}
+
+func resourceProjectPermissionsDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.RetryWithFallback(resourceProjectPermissionsDelete, ctx, d, meta, map[int]FallbackFn{
+		http.StatusNotFound: func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			d.SetId("")
+			return nil
+		},
+	})
+}
+
+func resourceProjectPermissionsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	if err := convergeProjectPermissions(ctx, meta.(sdkProject), d.Id(), nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourceProjectPermissionsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import project permissions", map[string]interface{}{"id": d.Id()})
+	if diags := projectReadiness.Retry(resourceProjectPermissionsRead, ctx, d, meta); diags.HasError() {
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// hashEmail hashes the email ignoring its case.
+func hashEmail(v interface{}) int {
+	return schema.HashString(strings.ToLower(v.(string)))
+}
+
+func getGrantees(d *schema.ResourceData) []string {
+	v := d.Get("grantees").(*schema.Set).List()
+	var o = make([]string, len(v))
+	for i, el := range v {
+		o[i] = el.(string)
+	}
+	return o
+}
+
+func listActiveProjectPermissions(client sdkProject, projectID string) ([]neon.ProjectPermission, error) {
+	resp, err := client.ListProjectPermissions(projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	var o []neon.ProjectPermission
+	for _, permission := range resp.ProjectPermissions {
+		if permission.RevokedAt == nil {
+			o = append(o, permission)
+		}
+	}
+	return o, nil
+}
+
+// convergeProjectPermissions revokes the project permissions of all users except the grantees,
+// and grants the permissions to the grantees who don't have them yet.
+func convergeProjectPermissions(ctx context.Context, client sdkProject, projectID string, grantees []string) error {
+	permissions, err := listActiveProjectPermissions(client, projectID)
+	if err != nil {
+		return err
+	}
+
+	var defined = make(map[string]struct{}, len(grantees))
+	for _, email := range grantees {
+		defined[strings.ToLower(email)] = struct{}{}
+	}
+
+	// the emails are matched case-insensitively
+	var granted = make(map[string]struct{}, len(permissions))
+	for _, permission := range permissions {
+		email := strings.ToLower(permission.GrantedToEmail)
+		if _, ok := defined[email]; ok {
+			granted[email] = struct{}{}
+			continue
+		}
+
+		tflog.Debug(ctx, "revoke project permission", map[string]interface{}{
+			"projectID": projectID,
+			"email":     permission.GrantedToEmail,
+		})
+		if _, err := client.RevokePermissionFromProject(projectID, permission.ID); err != nil {
+			return err
+		}
+	}
+
+	for _, email := range grantees {
+		if _, ok := granted[strings.ToLower(email)]; ok {
+			continue
+		}
+
+		tflog.Debug(ctx, "grant project permission", map[string]interface{}{
+			"projectID": projectID,
+			"email":     email,
+		})
+		if _, err := client.GrantPermissionToProject(
+			projectID, neon.GrantPermissionToProjectRequest{Email: email},
+		); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_resourceProjectPermissionsCreate(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	const projectID = "myproject"
+
+	t.Run("shall converge the project permissions to the grantees", func(t *testing.T) {
+		definition := resourceProjectPermissions().TestResourceData()
+		_ = definition.Set("project_id", projectID)
+		_ = definition.Set("grantees", []string{"foo@bar.baz", "qux@bar.baz"})
+
+		meta := &sdkClientStub{
+			stubProjectPermission: stubProjectPermission{
+				ProjectPermissions: neon.ProjectPermissions{
+					ProjectPermissions: []neon.ProjectPermission{
+						{ID: "foo", GrantedToEmail: "foo@bar.baz", GrantedAt: time.Now().UTC()},
+						{ID: "outsider", GrantedToEmail: "outsider@bar.baz", GrantedAt: time.Now().UTC()},
+					},
+				},
+			},
+		}
+
+		if err := resourceProjectPermissionsCreate(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if definition.Id() != projectID {
+			t.Errorf("unexpected resource ID: want=%s, got=%s", projectID, definition.Id())
+		}
+
+		var got []string
+		for _, permission := range meta.ProjectPermissions.ProjectPermissions {
+			got = append(got, permission.GrantedToEmail)
+		}
+		slices.Sort(got)
+		if want := []string{"foo@bar.baz", "qux@bar.baz"}; !slices.Equal(got, want) {
+			t.Errorf("unexpected grantees: want=%v, got=%v", want, got)
+		}
+
+		if meta.ProjectPermissions.ProjectPermissions[0].ID != "foo" {
+			t.Error("existing permission shall not be re-granted")
+		}
+
+		if n := definition.Get("grantees").(*schema.Set).Len(); n != 2 {
+			t.Errorf("unexpected number of grantees in the state: %d", n)
+		}
+	})
+
+	t.Run("shall match the grantees' emails case-insensitively", func(t *testing.T) {
+		definition := resourceProjectPermissions().TestResourceData()
+		_ = definition.Set("project_id", projectID)
+		_ = definition.Set("grantees", []string{"Foo@Bar.baz"})
+
+		meta := &sdkClientStub{
+			stubProjectPermission: stubProjectPermission{
+				ProjectPermissions: neon.ProjectPermissions{
+					ProjectPermissions: []neon.ProjectPermission{
+						{ID: "foo", GrantedToEmail: "foo@bar.baz", GrantedAt: time.Now().UTC()},
+					},
+				},
+			},
+		}
+
+		if err := resourceProjectPermissionsCreate(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if v := meta.ProjectPermissions.ProjectPermissions; len(v) != 1 || v[0].ID != "foo" {
+			t.Errorf("existing permission shall not be re-granted: %v", v)
+		}
+		if got := getGrantees(definition); !slices.Equal(got, []string{"Foo@Bar.baz"}) {
+			t.Errorf("defined email shall be kept in the state, got: %v", got)
+		}
+	})
+
+	t.Run("unhappy path", func(t *testing.T) {
+		definition := resourceProjectPermissions().TestResourceData()
+		_ = definition.Set("project_id", projectID)
+		_ = definition.Set("grantees", []string{"foo@bar.baz"})
+
+		meta := &sdkClientStub{
+			stubProjectPermission: stubProjectPermission{
+				err: errors.New("foobar"),
+			},
+		}
+
+		if err := resourceProjectPermissionsCreate(context.TODO(), definition, meta); err == nil {
+			t.Fatal("error expected")
+		}
+		if definition.Id() != "" {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+	})
+}
+
+func Test_resourceProjectPermissionsDelete(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	t.Run("shall revoke all project permissions", func(t *testing.T) {
+		definition := resourceProjectPermissions().TestResourceData()
+		definition.SetId("myproject")
+
+		meta := &sdkClientStub{
+			stubProjectPermission: stubProjectPermission{
+				ProjectPermissions: neon.ProjectPermissions{
+					ProjectPermissions: []neon.ProjectPermission{
+						{ID: "foo", GrantedToEmail: "foo@bar.baz", GrantedAt: time.Now().UTC()},
+						{ID: "bar", GrantedToEmail: "bar@bar.baz", GrantedAt: time.Now().UTC()},
+					},
+				},
+			},
+		}
+
+		if err := resourceProjectPermissionsDelete(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n := len(meta.ProjectPermissions.ProjectPermissions); n != 0 {
+			t.Errorf("all permissions expected to be revoked, %d left", n)
+		}
+		if definition.Id() != "" {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+	})
+}
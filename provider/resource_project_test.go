@@ -664,6 +664,33 @@ func Test_resourceProjectDefaultEndpointSettingsShallAllowToSetSuspensionTimeout
 	}
 }
 
+func Test_resourceProjectPgVersionValidation(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+	tests := map[string]struct {
+		in      int
+		isError bool
+	}{
+		"oldest supported version": {14, false},
+		"latest supported version": {18, false},
+		"unsupported old version":  {13, true},
+		"unsupported new version":  {19, true},
+	}
+	t.Parallel()
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, errs := resourceProject().Schema["pg_version"].ValidateFunc(test.in, "pg_version")
+			switch test.isError {
+			case true:
+				assert.Len(t, errs, 1)
+			case false:
+				assert.Nil(t, errs)
+			}
+		})
+	}
+}
+
 func Test_mapToDefaultEndpointsSettings_suspendTimeoutSeconds(t *testing.T) {
 	tests := map[string]struct {
 		in   int
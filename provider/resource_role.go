@@ -3,11 +3,16 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
 	neon "github.com/kislerdm/neon-sdk-go"
 )
 
@@ -15,6 +20,11 @@ func resourceRole() *schema.Resource {
 	return &schema.Resource{
 		Description: `Project Role. **Note** that User and Role are synonymous terms in Neon. 
 See details: https://neon.tech/docs/manage/users/
+
+The attributes login, createdb, createrole, connection_limit, valid_until, member_of and search_path
+are managed via SQL. The resource connects to the branch's first database as its owner
+via the branch's read-write endpoint, hence the endpoint must exist if any of these attributes is set.
+The membership in the role neon_superuser granted by Neon is not managed by the resource.
 `,
 		SchemaVersion: 7,
 		Importer: &schema.ResourceImporter{
@@ -22,6 +32,7 @@ See details: https://neon.tech/docs/manage/users/
 		},
 		CreateContext: resourceRoleCreateRetry,
 		ReadContext:   resourceRoleReadRetry,
+		UpdateContext: resourceRoleUpdateRetry,
 		DeleteContext: resourceRoleDeleteRetry,
 		Schema: map[string]*schema.Schema{
 			"project_id": {
@@ -52,10 +63,337 @@ See details: https://neon.tech/docs/manage/users/
 				Type:     schema.TypeBool,
 				Computed: true,
 			},
+			"login": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Allow the role to log in.",
+			},
+			"createdb": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Allow the role to create databases.",
+			},
+			"createrole": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Allow the role to create, alter and drop other roles.",
+			},
+			"connection_limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Maximum number of concurrent connections the role can make, -1 means no limit.",
+				ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+					if v := i.(int); v < -1 {
+						errs = append(errs, fmt.Errorf("%s must be greater or equal to -1, got: %d", s, v))
+					}
+					return
+				},
+			},
+			"valid_until": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				Description: `Timestamp in the RFC3339 format after which the role's password is no longer valid,
+"infinity" means the password never expires.`,
+				ValidateFunc: func(i interface{}, s string) (warns []string, errs []error) {
+					if v := i.(string); v != roleValidUntilInfinity {
+						if _, err := time.Parse(time.RFC3339, v); err != nil {
+							errs = append(errs, fmt.Errorf(`%s must be a RFC3339 timestamp or "infinity", got: %s`, s, v))
+						}
+					}
+					return
+				},
+				DiffSuppressFunc: func(k, oldValue, newValue string, d *schema.ResourceData) bool {
+					o, errOld := time.Parse(time.RFC3339, oldValue)
+					n, errNew := time.Parse(time.RFC3339, newValue)
+					return errOld == nil && errNew == nil && o.Equal(n)
+				},
+			},
+			"member_of": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Roles the role is a member of.",
+			},
+			"search_path": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Schemas search path of the role. The database default is used if empty.",
+			},
 		},
 	}
 }
 
+const roleValidUntilInfinity = "infinity"
+
+var roleSQLAttributes = []string{
+	"login", "createdb", "createrole", "connection_limit", "valid_until", "member_of", "search_path",
+}
+
+// roleSQLAttributesSet checks if the attributes managed via SQL are defined in the resource's configuration.
+func roleSQLAttributesSet(d *schema.ResourceData) func(k string) bool {
+	cfg := d.GetRawConfig()
+	return func(k string) bool {
+		return cfg.IsKnown() && !cfg.IsNull() && !cfg.GetAttr(k).IsNull()
+	}
+}
+
+// roleSQLAttributesManaged checks if the attributes managed via SQL were stored to the state.
+func roleSQLAttributesManaged(d *schema.ResourceData) bool {
+	state := d.GetRawState()
+	return state.IsKnown() && !state.IsNull() && !state.GetAttr("login").IsNull()
+}
+
+func anyRoleSQLAttribute(fn func(k string) bool) bool {
+	return slices.ContainsFunc(roleSQLAttributes, fn)
+}
+
+// roleSQLAttributesValue defines the Postgres role attributes read from the database.
+type roleSQLAttributesValue struct {
+	Login, CreateDB, CreateRole bool
+	ConnectionLimit             int
+	ValidUntil                  string
+	MemberOf                    []string
+	SearchPath                  []string
+}
+
+func newAlterRoleStatement(name string, d *schema.ResourceData, isSet func(k string) bool) string {
+	var options []string
+
+	var flag = func(k, option string) {
+		if !isSet(k) {
+			return
+		}
+		if d.Get(k).(bool) {
+			options = append(options, option)
+		} else {
+			options = append(options, "NO"+option)
+		}
+	}
+	flag("login", "LOGIN")
+	flag("createdb", "CREATEDB")
+	flag("createrole", "CREATEROLE")
+
+	if isSet("connection_limit") {
+		options = append(options, fmt.Sprintf("CONNECTION LIMIT %d", d.Get("connection_limit").(int)))
+	}
+	if isSet("valid_until") {
+		if v := d.Get("valid_until").(string); v != "" {
+			options = append(options, "VALID UNTIL "+quoteLiteral(v))
+		}
+	}
+
+	if len(options) == 0 {
+		return ""
+	}
+	return "ALTER ROLE " + quoteIdentifier(name) + " WITH " + strings.Join(options, " ")
+}
+
+func newRoleMembershipStatements(name string, current, expected []string) []string {
+	var o []string
+	for _, v := range difference(current, expected) {
+		o = append(o, "REVOKE "+quoteIdentifier(v)+" FROM "+quoteIdentifier(name))
+	}
+	for _, v := range difference(expected, current) {
+		o = append(o, "GRANT "+quoteIdentifier(v)+" TO "+quoteIdentifier(name))
+	}
+	return o
+}
+
+func newRoleSearchPathStatement(name string, searchPath []string) string {
+	if len(searchPath) == 0 {
+		return "ALTER ROLE " + quoteIdentifier(name) + " RESET search_path"
+	}
+	var schemas = make([]string, len(searchPath))
+	for i, v := range searchPath {
+		schemas[i] = quoteIdentifier(v)
+	}
+	return "ALTER ROLE " + quoteIdentifier(name) + " SET search_path TO " + strings.Join(schemas, ", ")
+}
+
+// parseRoleSearchPath parses the search_path setting stored in pg_db_role_setting, e.g. "$user", public.
+func parseRoleSearchPath(setconfig []string) []string {
+	const prefix = "search_path="
+
+	var v string
+	for _, el := range setconfig {
+		if strings.HasPrefix(el, prefix) {
+			v = strings.TrimPrefix(el, prefix)
+			break
+		}
+	}
+	return parsePostgresListSetting(v)
+}
+
+// parsePostgresListSetting parses the value of the setting defined as the list of identifiers, e.g. "$user", public.
+func parsePostgresListSetting(v string) []string {
+	var o = []string{}
+	if v == "" {
+		return o
+	}
+
+	var (
+		b      strings.Builder
+		quoted bool
+		runes  = []rune(v)
+	)
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; {
+		case c == '"' && quoted && i+1 < len(runes) && runes[i+1] == '"':
+			b.WriteRune('"')
+			i++
+		case c == '"':
+			quoted = !quoted
+		case c == ',' && !quoted:
+			o = append(o, b.String())
+			b.Reset()
+		case c == ' ' && !quoted:
+		default:
+			b.WriteRune(c)
+		}
+	}
+	return append(o, b.String())
+}
+
+func readRoleSQLAttributes(ctx context.Context, conn *pgx.Conn, name string) (roleSQLAttributesValue, error) {
+	const query = `SELECT r.rolcanlogin, r.rolcreatedb, r.rolcreaterole, r.rolconnlimit,
+	CASE
+		WHEN r.rolvaliduntil IS NULL THEN ''
+		WHEN r.rolvaliduntil = 'infinity' THEN 'infinity'
+		ELSE to_char(r.rolvaliduntil AT TIME ZONE 'UTC', 'YYYY-MM-DD"T"HH24:MI:SS"Z"')
+	END,
+	ARRAY(
+		SELECT DISTINCT m.rolname::text
+		FROM pg_auth_members am
+		JOIN pg_roles m ON m.oid = am.roleid
+		WHERE am.member = r.oid AND m.rolname <> 'neon_superuser'
+		ORDER BY 1
+	),
+	COALESCE(
+		(SELECT s.setconfig FROM pg_db_role_setting s WHERE s.setrole = r.oid AND s.setdatabase = 0),
+		'{}'
+	)
+FROM pg_roles r
+WHERE r.rolname = $1`
+
+	var (
+		o         roleSQLAttributesValue
+		setconfig []string
+	)
+	if err := conn.QueryRow(ctx, query, name).Scan(
+		&o.Login, &o.CreateDB, &o.CreateRole, &o.ConnectionLimit, &o.ValidUntil, &o.MemberOf, &setconfig,
+	); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return o, fmt.Errorf("role %s not found in the database", name)
+		}
+		return o, err
+	}
+	o.SearchPath = parseRoleSearchPath(setconfig)
+	return o, nil
+}
+
+func updateStateRoleSQLAttributes(d *schema.ResourceData, v roleSQLAttributesValue) error {
+	if err := d.Set("login", v.Login); err != nil {
+		return err
+	}
+	if err := d.Set("createdb", v.CreateDB); err != nil {
+		return err
+	}
+	if err := d.Set("createrole", v.CreateRole); err != nil {
+		return err
+	}
+	if err := d.Set("connection_limit", v.ConnectionLimit); err != nil {
+		return err
+	}
+	if err := d.Set("valid_until", v.ValidUntil); err != nil {
+		return err
+	}
+	if err := d.Set("member_of", v.MemberOf); err != nil {
+		return err
+	}
+	return d.Set("search_path", v.SearchPath)
+}
+
+// newRoleSQLTarget defines the database to connect to in order to manage the role's attributes.
+// The roles are shared across the databases, hence the first database of the branch is used.
+func newRoleSQLTarget(client sdkBranchDatabases, projectID, branchID string) (sqlTarget, error) {
+	resp, err := client.ListProjectBranchDatabases(projectID, branchID)
+	if err != nil {
+		return sqlTarget{}, err
+	}
+	if len(resp.Databases) == 0 {
+		return sqlTarget{}, fmt.Errorf("no database found in the branch %s of the project %s", branchID, projectID)
+	}
+	return sqlTarget{ProjectID: projectID, BranchID: branchID, Database: resp.Databases[0].Name}, nil
+}
+
+func withRoleSQLConn(ctx context.Context, d *schema.ResourceData, meta interface{}, fn func(conn *pgx.Conn) error) error {
+	t, err := newRoleSQLTarget(meta.(sdkBranchDatabases), d.Get("project_id").(string), d.Get("branch_id").(string))
+	if err != nil {
+		return err
+	}
+	return withSQLConn(ctx, meta, t, fn)
+}
+
+// applyRoleSQLAttributes alters the role's attributes defined by isSet, and reads all attributes back.
+func applyRoleSQLAttributes(ctx context.Context, d *schema.ResourceData, meta interface{}, isSet func(k string) bool) error {
+	name := d.Get("name").(string)
+	tflog.Trace(ctx, "alter Role attributes", map[string]interface{}{"name": name})
+
+	return withRoleSQLConn(ctx, d, meta, func(conn *pgx.Conn) error {
+		current, err := readRoleSQLAttributes(ctx, conn, name)
+		if err != nil {
+			return err
+		}
+
+		var statements []string
+		if s := newAlterRoleStatement(name, d, isSet); s != "" {
+			statements = append(statements, s)
+		}
+		if isSet("member_of") {
+			statements = append(statements,
+				newRoleMembershipStatements(name, current.MemberOf, getStringSet(d.Get("member_of")))...,
+			)
+		}
+		if isSet("search_path") {
+			var searchPath []string
+			for _, v := range d.Get("search_path").([]interface{}) {
+				searchPath = append(searchPath, v.(string))
+			}
+			statements = append(statements, newRoleSearchPathStatement(name, searchPath))
+		}
+
+		for _, q := range statements {
+			if _, err := conn.Exec(ctx, q); err != nil {
+				return err
+			}
+		}
+
+		if current, err = readRoleSQLAttributes(ctx, conn, name); err != nil {
+			return err
+		}
+		return updateStateRoleSQLAttributes(d, current)
+	})
+}
+
+func readRoleSQLAttributesToState(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	name := d.Get("name").(string)
+	return withRoleSQLConn(ctx, d, meta, func(conn *pgx.Conn) error {
+		v, err := readRoleSQLAttributes(ctx, conn, name)
+		if err != nil {
+			return err
+		}
+		return updateStateRoleSQLAttributes(d, v)
+	})
+}
+
 func updateStateRole(d *schema.ResourceData, v neon.Role) error {
 	if err := d.Set("name", v.Name); err != nil {
 		return err
@@ -107,7 +445,14 @@ func resourceRoleCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		role.Password = pointer(r.Password)
 	}
 
-	return updateStateRole(d, role)
+	if err := updateStateRole(d, role); err != nil {
+		return err
+	}
+
+	if isSet := roleSQLAttributesSet(d); anyRoleSQLAttribute(isSet) {
+		return applyRoleSQLAttributes(ctx, d, meta, isSet)
+	}
+	return nil
 }
 
 func resourceRoleReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -145,7 +490,28 @@ func resourceRoleRead(ctx context.Context, d *schema.ResourceData, meta interfac
 		role.Password = pointer(r.Password)
 	}
 
-	return updateStateRole(d, role)
+	if err := updateStateRole(d, role); err != nil {
+		return err
+	}
+
+	if roleSQLAttributesManaged(d) {
+		return readRoleSQLAttributesToState(ctx, d, meta)
+	}
+	return nil
+}
+
+func resourceRoleUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceRoleUpdate, ctx, d, meta)
+}
+
+func resourceRoleUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "update Role", map[string]interface{}{"id": d.Id()})
+
+	isSet := func(k string) bool { return d.HasChange(k) }
+	if !anyRoleSQLAttribute(isSet) {
+		return nil
+	}
+	return applyRoleSQLAttributes(ctx, d, meta, isSet)
 }
 
 func resourceRoleDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -0,0 +1,161 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"slices"
+	"testing"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_newAlterRoleStatement(t *testing.T) {
+	d := resourceRole().TestResourceData()
+	_ = d.Set("login", false)
+	_ = d.Set("createdb", true)
+	_ = d.Set("createrole", false)
+	_ = d.Set("connection_limit", 10)
+	_ = d.Set("valid_until", "2030-01-01T00:00:00Z")
+
+	tests := []struct {
+		name string
+		keys []string
+		want string
+	}{
+		{
+			name: "all attributes",
+			keys: roleSQLAttributes,
+			want: `ALTER ROLE "foo" WITH NOLOGIN CREATEDB NOCREATEROLE CONNECTION LIMIT 10 VALID UNTIL '2030-01-01T00:00:00Z'`,
+		},
+		{
+			name: "single attribute",
+			keys: []string{"createdb"},
+			want: `ALTER ROLE "foo" WITH CREATEDB`,
+		},
+		{
+			name: "no attributes",
+			keys: []string{"member_of", "search_path"},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isSet := func(k string) bool { return slices.Contains(tt.keys, k) }
+			if got := newAlterRoleStatement("foo", d, isSet); got != tt.want {
+				t.Errorf("newAlterRoleStatement() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_newRoleMembershipStatements(t *testing.T) {
+	got := newRoleMembershipStatements("foo", []string{"bar", "baz"}, []string{"baz", "qux"})
+	want := []string{`REVOKE "bar" FROM "foo"`, `GRANT "qux" TO "foo"`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("newRoleMembershipStatements() = %v, want %v", got, want)
+	}
+}
+
+func Test_newRoleSearchPathStatement(t *testing.T) {
+	if got, want := newRoleSearchPathStatement("foo", []string{"$user", "public"}),
+		`ALTER ROLE "foo" SET search_path TO "$user", "public"`; got != want {
+		t.Errorf("newRoleSearchPathStatement() = %v, want %v", got, want)
+	}
+	if got, want := newRoleSearchPathStatement("foo", nil), `ALTER ROLE "foo" RESET search_path`; got != want {
+		t.Errorf("newRoleSearchPathStatement() = %v, want %v", got, want)
+	}
+}
+
+func Test_parseRoleSearchPath(t *testing.T) {
+	tests := []struct {
+		name      string
+		setconfig []string
+		want      []string
+	}{
+		{
+			name:      "not set",
+			setconfig: []string{"statement_timeout=0"},
+			want:      []string{},
+		},
+		{
+			name:      "quoted identifiers",
+			setconfig: []string{"statement_timeout=0", `search_path="$user", public, "foo ""bar"""`},
+			want:      []string{"$user", "public", `foo "bar"`},
+		},
+		{
+			name:      "comma in quoted identifier",
+			setconfig: []string{`search_path="a,b", c`},
+			want:      []string{"a,b", "c"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRoleSearchPath(tt.setconfig); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseRoleSearchPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_applyRoleSQLAttributes(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	meta := newTestSQLMeta(t)
+	meta.stubDatabases = stubDatabases{
+		Databases: []neon.Database{{BranchID: "br-foo", Name: "postgres"}},
+	}
+
+	execTestSQL(t, meta, `CREATE ROLE role_test_member`, `CREATE ROLE role_test_group`, `CREATE ROLE role_test_other`,
+		`GRANT role_test_other TO role_test_member`)
+	t.Cleanup(func() {
+		execTestSQL(t, meta, `DROP ROLE role_test_member`, `DROP ROLE role_test_group`, `DROP ROLE role_test_other`)
+	})
+
+	definition := resourceRole().TestResourceData()
+	_ = definition.Set("project_id", "myproject")
+	_ = definition.Set("branch_id", "br-foo")
+	_ = definition.Set("name", "role_test_member")
+	_ = definition.Set("login", true)
+	_ = definition.Set("connection_limit", 5)
+	_ = definition.Set("valid_until", "2030-01-01T00:00:00Z")
+	_ = definition.Set("member_of", []string{"role_test_group"})
+	_ = definition.Set("search_path", []string{"$user", "public"})
+
+	if err := applyRoleSQLAttributes(context.TODO(), definition, meta, func(string) bool { return true }); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !definition.Get("login").(bool) || definition.Get("createdb").(bool) {
+		t.Error("unexpected role flags")
+	}
+	if v := definition.Get("connection_limit").(int); v != 5 {
+		t.Errorf("unexpected connection_limit: %d", v)
+	}
+	if v := definition.Get("valid_until").(string); v != "2030-01-01T00:00:00Z" {
+		t.Errorf("unexpected valid_until: %s", v)
+	}
+	if got, want := getStringSet(definition.Get("member_of")), []string{"role_test_group"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("unexpected member_of: want=%v, got=%v", want, got)
+	}
+	if got := definition.Get("search_path").([]interface{}); len(got) != 2 || got[0] != "$user" || got[1] != "public" {
+		t.Errorf("unexpected search_path: %v", got)
+	}
+
+	// the drift shall be detected
+	execTestSQL(t, meta, `ALTER ROLE role_test_member WITH CREATEDB`, `ALTER ROLE role_test_member RESET search_path`)
+	if err := readRoleSQLAttributesToState(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !definition.Get("createdb").(bool) {
+		t.Error("createdb drift expected")
+	}
+	if n := len(definition.Get("search_path").([]interface{})); n != 0 {
+		t.Errorf("search_path drift expected, got %d schemas", n)
+	}
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func resourceSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Description: `Branch Snapshot. A named restore point of the branch's data at the given LSN, or timestamp.
+See details: https://neon.com/docs/guides/backup-restore
+
+**Note** that the feature is in Beta.`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSnapshotImport,
+		},
+		CreateContext: resourceSnapshotCreateRetry,
+		ReadContext:   resourceSnapshotReadRetry,
+		UpdateContext: resourceSnapshotUpdateRetry,
+		DeleteContext: resourceSnapshotDeleteRetry,
+		CustomizeDiff: newHistoryRetentionCheck("timestamp"),
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Snapshot ID.",
+			},
+			"project_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Project ID.",
+			},
+			"branch_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the branch to take the snapshot of.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Snapshot name.",
+			},
+			"lsn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"timestamp"},
+				Description: `Log Sequence Number (LSN) of the branch's data to snapshot.
+See details: https://neon.tech/docs/reference/glossary/#lsn`,
+			},
+			"timestamp": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				Computed:      true,
+				ForceNew:      true,
+				ValidateFunc:  intValidationNotNegative,
+				ConflictsWith: []string{"lsn"},
+				Description: `Point in time of the branch's data to snapshot.
+It cannot reach further back than the project's ` + "`history_retention_seconds`" + `.
+**Note**: it's defined as Unix epoch.`,
+			},
+			"expires_at": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: intValidationNotNegative,
+				Description: `Timestamp when the snapshot will be deleted automatically. The snapshot never expires if not set.
+**Note**: it's defined as Unix epoch.`,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Snapshot creation timestamp.",
+			},
+			"manual": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Flag of the snapshot taken on demand, not by schedule.",
+			},
+		},
+	}
+}
+
+func updateStateSnapshot(d *schema.ResourceData, v neon.Snapshot) error {
+	if err := d.Set("name", v.Name); err != nil {
+		return err
+	}
+	if v.SourceBranchID != nil {
+		if err := d.Set("branch_id", *v.SourceBranchID); err != nil {
+			return err
+		}
+	}
+	if v.Lsn != nil {
+		if err := d.Set("lsn", *v.Lsn); err != nil {
+			return err
+		}
+	}
+	if v.Timestamp != nil {
+		ts, err := parseSnapshotTimestamp(*v.Timestamp)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("timestamp", ts); err != nil {
+			return err
+		}
+	}
+	if v.ExpiresAt != nil {
+		ts, err := parseSnapshotTimestamp(*v.ExpiresAt)
+		if err != nil {
+			return err
+		}
+		if err := d.Set("expires_at", ts); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("created_at", v.CreatedAt); err != nil {
+		return err
+	}
+	var manual bool
+	if v.Manual != nil {
+		manual = *v.Manual
+	}
+	if err := d.Set("manual", manual); err != nil {
+		return err
+	}
+	return nil
+}
+
+func parseSnapshotTimestamp(s string) (int, error) {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, err
+	}
+	return int(t.Unix()), nil
+}
+
+func formatSnapshotTimestamp(v int) *string {
+	return pointer(time.Unix(int64(v), 0).UTC().Format(time.RFC3339))
+}
+
+func resourceSnapshotCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceSnapshotCreate, ctx, d, meta)
+}
+
+func resourceSnapshotCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "create Snapshot")
+
+	var lsn, timestamp, name, expiresAt *string
+	if v, ok := d.GetOk("lsn"); ok && v.(string) != "" {
+		lsn = pointer(v.(string))
+	}
+	if v, ok := d.GetOk("timestamp"); ok && v.(int) > 0 {
+		timestamp = formatSnapshotTimestamp(v.(int))
+	}
+	if v, ok := d.GetOk("name"); ok && v.(string) != "" {
+		name = pointer(url.QueryEscape(v.(string)))
+	}
+	if v, ok := d.GetOk("expires_at"); ok && v.(int) > 0 {
+		expiresAt = formatSnapshotTimestamp(v.(int))
+	}
+
+	client := meta.(sdkSnapshot)
+	resp, err := client.CreateSnapshot(
+		d.Get("project_id").(string), d.Get("branch_id").(string), lsn, timestamp, name, expiresAt,
+	)
+	if err != nil {
+		return err
+	}
+	waitUnfinishedOperations(ctx, client, resp.Operations)
+
+	d.SetId(resp.Snapshot.ID)
+	return updateStateSnapshot(d, resp.Snapshot)
+}
+
+func resourceSnapshotReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.RetryWithFallback(resourceSnapshotRead, ctx, d, meta, map[int]FallbackFn{
+		http.StatusNotFound: func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			tflog.Debug(ctx, "snapshot not found, removing from state",
+				map[string]interface{}{"id": d.Id(), "project_id": d.Get("project_id")})
+			d.SetId("")
+			return nil
+		}})
+}
+
+func resourceSnapshotRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "read Snapshot", map[string]interface{}{"id": d.Id()})
+
+	resp, err := meta.(sdkSnapshot).ListSnapshots(d.Get("project_id").(string))
+	if err != nil {
+		return err
+	}
+
+	for _, v := range resp.Snapshots {
+		if v.ID == d.Id() {
+			return updateStateSnapshot(d, v)
+		}
+	}
+
+	tflog.Debug(ctx, "snapshot not found, removing from state",
+		map[string]interface{}{"id": d.Id(), "project_id": d.Get("project_id")})
+	d.SetId("")
+	return nil
+}
+
+func resourceSnapshotUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceSnapshotUpdate, ctx, d, meta)
+}
+
+func resourceSnapshotUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "update Snapshot", map[string]interface{}{"id": d.Id()})
+
+	if !d.HasChange("name") {
+		return nil
+	}
+
+	resp, err := meta.(sdkSnapshot).UpdateSnapshot(d.Get("project_id").(string), d.Id(),
+		neon.SnapshotUpdateRequest{
+			Snapshot: neon.SnapshotUpdateRequestSnapshot{
+				Name: pointer(d.Get("name").(string)),
+			},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return updateStateSnapshot(d, resp.Snapshot)
+}
+
+func resourceSnapshotDeleteRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.RetryWithFallback(resourceSnapshotDelete, ctx, d, meta, map[int]FallbackFn{
+		http.StatusNotFound: func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			d.SetId("")
+			return nil
+		},
+	})
+}
+
+func resourceSnapshotDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "delete Snapshot", map[string]interface{}{"id": d.Id()})
+
+	if err := meta.(sdkSnapshot).DeleteSnapshot(d.Get("project_id").(string), d.Id()); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func resourceSnapshotImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import Snapshot")
+
+	els := strings.SplitN(d.Id(), "/", 2)
+	if len(els) != 2 {
+		return nil, fmt.Errorf("invalid identifier, expected {{.ProjectID}}/{{.SnapshotID}}")
+	}
+	if err := d.Set("project_id", els[0]); err != nil {
+		return nil, err
+	}
+	d.SetId(els[1])
+
+	if diags := projectReadiness.Retry(resourceSnapshotRead, ctx, d, meta); diags.HasError() {
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	if d.Id() == "" {
+		_ = d.Set("project_id", "")
+		return nil, errors.New("no snapshot found")
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+type sdkSnapshot interface {
+	CreateSnapshot(projectID string, branchID string, lsn *string, timestamp *string, name *string,
+		expiresAt *string) (neon.CreateSnapshotRespObj, error)
+	ListSnapshots(projectID string) (neon.ListSnapshotsRespObj, error)
+	UpdateSnapshot(projectID string, snapshotID string, cfg neon.SnapshotUpdateRequest) (neon.UpdateSnapshotRespObj,
+		error)
+	DeleteSnapshot(projectID string, snapshotID string) error
+	opsReader
+}
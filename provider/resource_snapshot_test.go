@@ -0,0 +1,152 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"os"
+	"testing"
+	"time"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func Test_resourceSnapshotCreate(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	const (
+		projectID = "myproject"
+		branchID  = "br-foo"
+		name      = "foo"
+		timestamp = 1700000000
+	)
+
+	t.Run("shall create the snapshot at the given timestamp", func(t *testing.T) {
+		definition := resourceSnapshot().TestResourceData()
+		_ = definition.Set("project_id", projectID)
+		_ = definition.Set("branch_id", branchID)
+		_ = definition.Set("name", name)
+		_ = definition.Set("timestamp", timestamp)
+
+		meta := &sdkClientStub{}
+		if err := resourceSnapshotCreate(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if len(meta.Snapshots) != 1 {
+			t.Fatalf("one snapshot expected, got %d", len(meta.Snapshots))
+		}
+		if definition.Id() != meta.Snapshots[0].ID {
+			t.Errorf("unexpected resource ID: want=%s, got=%s", meta.Snapshots[0].ID, definition.Id())
+		}
+		if got := *meta.Snapshots[0].Timestamp; got != "2023-11-14T22:13:20Z" {
+			t.Errorf("unexpected timestamp sent: %s", got)
+		}
+		if got := definition.Get("timestamp").(int); got != timestamp {
+			t.Errorf("unexpected timestamp: want=%d, got=%d", timestamp, got)
+		}
+		if got := definition.Get("branch_id").(string); got != branchID {
+			t.Errorf("unexpected branch_id: want=%s, got=%s", branchID, got)
+		}
+	})
+
+	t.Run("unhappy path", func(t *testing.T) {
+		definition := resourceSnapshot().TestResourceData()
+		_ = definition.Set("project_id", projectID)
+		_ = definition.Set("branch_id", branchID)
+
+		meta := &sdkClientStub{stubSnapshot: stubSnapshot{err: errors.New("foobar")}}
+		if err := resourceSnapshotCreate(context.TODO(), definition, meta); err == nil {
+			t.Fatal("error expected")
+		}
+		if definition.Id() != "" {
+			t.Errorf("unexpected resource ID: %s", definition.Id())
+		}
+	})
+}
+
+func Test_resourceSnapshotRead(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	t.Parallel()
+
+	t.Run("shall remove the snapshot from the state if it's not found", func(t *testing.T) {
+		definition := resourceSnapshot().TestResourceData()
+		definition.SetId("missing")
+		_ = definition.Set("project_id", "myproject")
+
+		meta := &sdkClientStub{stubSnapshot: stubSnapshot{Snapshots: []neon.Snapshot{{ID: "foo"}}}}
+		if err := resourceSnapshotRead(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if definition.Id() != "" {
+			t.Errorf("resource ID expected to be reset, got %s", definition.Id())
+		}
+	})
+
+	t.Run("shall import the snapshot", func(t *testing.T) {
+		definition := resourceSnapshot().TestResourceData()
+		definition.SetId("myproject/foo")
+
+		meta := &sdkClientStub{stubSnapshot: stubSnapshot{Snapshots: []neon.Snapshot{
+			{ID: "foo", Name: "bar", SourceBranchID: pointer("br-foo"), ExpiresAt: pointer("2023-11-14T22:13:20Z")},
+		}}}
+		resources, err := resourceSnapshotImport(context.TODO(), definition, meta)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		d := resources[0]
+		if d.Id() != "foo" {
+			t.Errorf("unexpected ID: %s", d.Id())
+		}
+		if got := d.Get("project_id").(string); got != "myproject" {
+			t.Errorf("unexpected project_id: %s", got)
+		}
+		if got := d.Get("name").(string); got != "bar" {
+			t.Errorf("unexpected name: %s", got)
+		}
+		if got := d.Get("expires_at").(int); got != 1700000000 {
+			t.Errorf("unexpected expires_at: %d", got)
+		}
+	})
+}
+
+func Test_checkHistoryRetention(t *testing.T) {
+	now := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		ts      time.Time
+		wantErr bool
+	}{
+		{
+			name: "within the retention window",
+			ts:   now.Add(-time.Hour),
+		},
+		{
+			name: "at the edge of the retention window",
+			ts:   now.Add(-24 * time.Hour),
+		},
+		{
+			name:    "beyond the retention window",
+			ts:      now.Add(-25 * time.Hour),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := checkHistoryRetention("parent_timestamp", tt.ts, 86400, now); (err != nil) != tt.wantErr {
+				t.Errorf("checkHistoryRetention() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,512 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+const defaultSQLMigrationsTrackingTable = "neon_sql_migrations"
+
+func resourceSQLMigrations() *schema.Resource {
+	return &schema.Resource{
+		Description: `Versioned SQL migrations applied to the database of the branch.
+
+The migrations are applied in the defined order, every migration is applied in a dedicated transaction,
+hence the statements which cannot run in the transaction block, e.g. CREATE INDEX CONCURRENTLY, are not supported.
+The version of the migration is the file name without the extension ".sql".
+The versions and the checksums of the applied migrations are recorded in the tracking table.
+The plan shows the pending migrations, and fails if the checksum of the applied migration's file changed.
+
+The resource connects to the database via the branch's read-write endpoint, hence the endpoint must exist.
+The resource's deletion only removes it from the state, the applied migrations are not reverted.
+`,
+		SchemaVersion: 1,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSQLMigrationsImport,
+		},
+		CreateContext: resourceSQLMigrationsCreateRetry,
+		ReadContext:   resourceSQLMigrationsReadRetry,
+		UpdateContext: resourceSQLMigrationsUpdateRetry,
+		DeleteContext: resourceSQLMigrationsDelete,
+		CustomizeDiff: resourceSQLMigrationsCustomizeDiff,
+		Schema: newSchemaSQLTarget(map[string]*schema.Schema{
+			"dir": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"dir", "files"},
+				Description:  "Directory with the migrations. The files with the extension \".sql\" are applied in the lexical order.",
+			},
+			"files": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"dir", "files"},
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Description:  "Paths to the migrations' files in the order of application.",
+			},
+			"tracking_table": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  defaultSQLMigrationsTrackingTable,
+				Description: `Name of the table to record the applied migrations in.
+The table is created in the first schema of the connecting role's search path.`,
+			},
+			"dry_run": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+				Description: `Apply the pending migrations to the temporary child branch of the branch instead of the branch itself.
+The child branch with the read-write endpoint is created for every run, and deleted afterwards.
+The outcome is recorded in the attribute "dry_run_migrations", the migrations are not recorded as applied.
+The dry-run is repeated only if the pending migrations change.`,
+			},
+			"migrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Migrations applied to the branch.",
+				Elem:        newSchemaSQLMigration(),
+			},
+			"dry_run_migrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Pending migrations which were applied successfully to the temporary branch by the last dry-run.",
+				Elem:        newSchemaSQLMigration(),
+			},
+		}),
+	}
+}
+
+func newSchemaSQLMigration() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Migration version.",
+			},
+			"checksum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA256 checksum of the migration's file.",
+			},
+		},
+	}
+}
+
+// sqlMigration defines the migration's file, or the applied migration read from the tracking table.
+type sqlMigration struct {
+	Version, Checksum, Path string
+}
+
+func newSQLMigration(path string) (sqlMigration, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return sqlMigration{}, err
+	}
+	sum := sha256.Sum256(b)
+	return sqlMigration{
+		Version:  strings.TrimSuffix(filepath.Base(path), ".sql"),
+		Checksum: hex.EncodeToString(sum[:]),
+		Path:     path,
+	}, nil
+}
+
+// listSQLMigrations lists the migrations' files defined either by the directory, or by the list of paths.
+func listSQLMigrations(dir string, files []interface{}) ([]sqlMigration, error) {
+	var paths []string
+	if dir != "" {
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range entries {
+			if !v.IsDir() && filepath.Ext(v.Name()) == ".sql" {
+				paths = append(paths, filepath.Join(dir, v.Name()))
+			}
+		}
+	}
+	for _, v := range files {
+		paths = append(paths, v.(string))
+	}
+
+	var (
+		o    = make([]sqlMigration, 0, len(paths))
+		seen = map[string]struct{}{}
+	)
+	for _, path := range paths {
+		v, err := newSQLMigration(path)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := seen[v.Version]; ok {
+			return nil, fmt.Errorf("duplicate migration version %s", v.Version)
+		}
+		seen[v.Version] = struct{}{}
+		o = append(o, v)
+	}
+	return o, nil
+}
+
+// pendingSQLMigrations returns the migrations which were not applied,
+// and fails if the checksum of the applied migration differs from its file's.
+func pendingSQLMigrations(applied, migrations []sqlMigration) ([]sqlMigration, error) {
+	var checksums = make(map[string]string, len(applied))
+	for _, v := range applied {
+		checksums[v.Version] = v.Checksum
+	}
+
+	var o []sqlMigration
+	for _, v := range migrations {
+		checksum, ok := checksums[v.Version]
+		switch {
+		case !ok:
+			o = append(o, v)
+		case checksum != v.Checksum:
+			return nil, fmt.Errorf("checksum of the applied migration %s changed: applied=%s, file=%s",
+				v.Version, checksum, v.Checksum)
+		}
+	}
+	return o, nil
+}
+
+func getSQLMigrationsState(d interface{ Get(string) interface{} }) []sqlMigration {
+	return getSQLMigrationsAttr(d, "migrations")
+}
+
+func getSQLMigrationsAttr(d interface{ Get(string) interface{} }, key string) []sqlMigration {
+	var o []sqlMigration
+	for _, v := range d.Get(key).([]interface{}) {
+		el := v.(map[string]interface{})
+		o = append(o, sqlMigration{Version: el["version"].(string), Checksum: el["checksum"].(string)})
+	}
+	return o
+}
+
+func newSQLMigrationsAttr(v []sqlMigration) []map[string]interface{} {
+	var o = make([]map[string]interface{}, len(v))
+	for i, el := range v {
+		o[i] = map[string]interface{}{"version": el.Version, "checksum": el.Checksum}
+	}
+	return o
+}
+
+func updateStateSQLMigrations(d *schema.ResourceData, v []sqlMigration) error {
+	return d.Set("migrations", newSQLMigrationsAttr(v))
+}
+
+// equalSQLMigrations compares the versions and the checksums of the migrations.
+func equalSQLMigrations(a, b []sqlMigration) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].Version != b[i].Version || a[i].Checksum != b[i].Checksum {
+			return false
+		}
+	}
+	return true
+}
+
+func resourceSQLMigrationsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("dir") || !d.NewValueKnown("files") {
+		if err := d.SetNewComputed("dry_run_migrations"); err != nil {
+			return err
+		}
+		return d.SetNewComputed("migrations")
+	}
+
+	migrations, err := listSQLMigrations(d.Get("dir").(string), d.Get("files").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	applied := getSQLMigrationsState(d)
+	pending, err := pendingSQLMigrations(applied, migrations)
+	if err != nil {
+		return err
+	}
+
+	// the dry-run does not change the branch, hence it is repeated only if the pending migrations change
+	if d.Get("dry_run").(bool) {
+		if equalSQLMigrations(getSQLMigrationsAttr(d, "dry_run_migrations"), pending) {
+			return nil
+		}
+		tflog.Debug(ctx, "pending SQL migrations to dry-run", map[string]interface{}{
+			"id": d.Id(), "count": len(pending),
+		})
+		return d.SetNew("dry_run_migrations", newSQLMigrationsAttr(pending))
+	}
+
+	if len(getSQLMigrationsAttr(d, "dry_run_migrations")) > 0 {
+		if err := d.SetNew("dry_run_migrations", newSQLMigrationsAttr(nil)); err != nil {
+			return err
+		}
+	}
+	if len(pending) == 0 {
+		return nil
+	}
+
+	tflog.Debug(ctx, "pending SQL migrations", map[string]interface{}{"id": d.Id(), "count": len(pending)})
+	return d.SetNew("migrations", newSQLMigrationsAttr(append(applied, pending...)))
+}
+
+func newSQLMigrationsTrackingTableStatement(table string) string {
+	return "CREATE TABLE IF NOT EXISTS " + quoteIdentifier(table) + ` (
+	id bigint GENERATED ALWAYS AS IDENTITY,
+	version text PRIMARY KEY,
+	checksum text NOT NULL,
+	applied_at timestamptz NOT NULL DEFAULT now()
+)`
+}
+
+// readAppliedSQLMigrations reads the applied migrations from the tracking table, no migrations are returned
+// if the tracking table does not exist.
+func readAppliedSQLMigrations(ctx context.Context, conn *pgx.Conn, table string) ([]sqlMigration, error) {
+	var exists bool
+	if err := conn.QueryRow(ctx, `SELECT to_regclass($1) IS NOT NULL`, quoteIdentifier(table)).
+		Scan(&exists); err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, nil
+	}
+
+	rows, err := conn.Query(ctx, "SELECT version, checksum FROM "+quoteIdentifier(table)+" ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	return pgx.CollectRows(rows, func(row pgx.CollectableRow) (sqlMigration, error) {
+		var v sqlMigration
+		err := row.Scan(&v.Version, &v.Checksum)
+		return v, err
+	})
+}
+
+// applySQLMigrations applies the pending migrations and returns all applied migrations.
+func applySQLMigrations(ctx context.Context, meta interface{}, t sqlTarget, table string, migrations []sqlMigration) (
+	[]sqlMigration, error,
+) {
+	var applied []sqlMigration
+	err := withSQLConn(ctx, meta, t, func(conn *pgx.Conn) error {
+		if _, err := conn.Exec(ctx, newSQLMigrationsTrackingTableStatement(table)); err != nil {
+			return err
+		}
+
+		var err error
+		if applied, err = readAppliedSQLMigrations(ctx, conn, table); err != nil {
+			return err
+		}
+
+		pending, err := pendingSQLMigrations(applied, migrations)
+		if err != nil {
+			return err
+		}
+
+		for _, v := range pending {
+			q, err := os.ReadFile(v.Path)
+			if err != nil {
+				return err
+			}
+
+			tflog.Debug(ctx, "apply SQL migration", map[string]interface{}{
+				"branch_id": t.BranchID, "database": t.Database, "version": v.Version,
+			})
+			if err := pgx.BeginFunc(ctx, conn, func(tx pgx.Tx) error {
+				if _, err := tx.Exec(ctx, string(q)); err != nil {
+					return err
+				}
+				_, err := tx.Exec(ctx,
+					"INSERT INTO "+quoteIdentifier(table)+" (version, checksum) VALUES ($1, $2)",
+					v.Version, v.Checksum,
+				)
+				return err
+			}); err != nil {
+				return fmt.Errorf("migration %s failed: %w", v.Version, err)
+			}
+		}
+
+		applied, err = readAppliedSQLMigrations(ctx, conn, table)
+		return err
+	})
+	return applied, err
+}
+
+type sdkSQLMigrationsDryRun interface {
+	CreateProjectBranch(projectID string, cfg *neon.CreateProjectBranchReqObj) (neon.CreatedBranch, error)
+	DeleteProjectBranch(projectID string, branchID string) (neon.BranchOperations, error)
+	opsReader
+}
+
+// dryRunSQLMigrations applies the migrations to the temporary child branch which is deleted afterwards.
+func dryRunSQLMigrations(ctx context.Context, meta interface{}, t sqlTarget, table string, migrations []sqlMigration) (
+	err error,
+) {
+	client := meta.(sdkSQLMigrationsDryRun)
+	resp, err := client.CreateProjectBranch(t.ProjectID, &neon.CreateProjectBranchReqObj{
+		BranchCreateRequest: neon.BranchCreateRequest{
+			Branch: &neon.BranchCreateRequestBranch{
+				ParentID: pointer(t.BranchID),
+				Name:     pointer("dry-run-" + t.BranchID + "-" + strconv.FormatInt(time.Now().Unix(), 10)),
+			},
+			Endpoints: &[]neon.BranchCreateRequestEndpointOptions{
+				{Type: endpointTypeRW},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	waitUnfinishedOperations(ctx, client, resp.OperationsResponse.Operations)
+
+	branchID := resp.BranchResponse.Branch.ID
+	tflog.Debug(ctx, "created branch for SQL migrations dry-run", map[string]interface{}{"branch_id": branchID})
+	defer func() {
+		r, errDelete := client.DeleteProjectBranch(t.ProjectID, branchID)
+		if errDelete != nil {
+			err = errors.Join(err, errDelete)
+			return
+		}
+		waitUnfinishedOperations(ctx, client, r.OperationsResponse.Operations)
+	}()
+
+	t.BranchID = branchID
+	_, err = applySQLMigrations(ctx, meta, t, table, migrations)
+	return err
+}
+
+func resourceSQLMigrationsApply(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	migrations, err := listSQLMigrations(d.Get("dir").(string), d.Get("files").([]interface{}))
+	if err != nil {
+		return err
+	}
+
+	t := newSQLTarget(d)
+	table := d.Get("tracking_table").(string)
+
+	if d.Get("dry_run").(bool) {
+		// the migrations are recorded as applied only if they were applied to the branch
+		var applied []sqlMigration
+		if err := withSQLConn(ctx, meta, t, func(conn *pgx.Conn) error {
+			var err error
+			applied, err = readAppliedSQLMigrations(ctx, conn, table)
+			return err
+		}); err != nil {
+			return err
+		}
+
+		pending, err := pendingSQLMigrations(applied, migrations)
+		if err != nil {
+			return err
+		}
+		if len(pending) > 0 {
+			if err := dryRunSQLMigrations(ctx, meta, t, table, migrations); err != nil {
+				return err
+			}
+		}
+
+		if err := updateStateSQLMigrations(d, applied); err != nil {
+			return err
+		}
+		return d.Set("dry_run_migrations", newSQLMigrationsAttr(pending))
+	}
+
+	applied, err := applySQLMigrations(ctx, meta, t, table, migrations)
+	if err != nil {
+		return err
+	}
+	if err := updateStateSQLMigrations(d, applied); err != nil {
+		return err
+	}
+	return d.Set("dry_run_migrations", newSQLMigrationsAttr(nil))
+}
+
+func resourceSQLMigrationsCreateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceSQLMigrationsCreate, ctx, d, meta)
+}
+
+func resourceSQLMigrationsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	t := newSQLTarget(d)
+	r := newSQLObjectID(t, d.Get("tracking_table").(string))
+	tflog.Trace(ctx, "create SQL Migrations", map[string]interface{}{"id": r.toString()})
+
+	if err := resourceSQLMigrationsApply(ctx, d, meta); err != nil {
+		return err
+	}
+	d.SetId(r.toString())
+	return nil
+}
+
+func resourceSQLMigrationsReadRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return retrySQLRead(resourceSQLMigrationsRead, ctx, d, meta)
+}
+
+func resourceSQLMigrationsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "read SQL Migrations", map[string]interface{}{"id": d.Id()})
+
+	var applied []sqlMigration
+	if err := withSQLConn(ctx, meta, newSQLTarget(d), func(conn *pgx.Conn) error {
+		var err error
+		applied, err = readAppliedSQLMigrations(ctx, conn, d.Get("tracking_table").(string))
+		return err
+	}); err != nil {
+		return err
+	}
+	return updateStateSQLMigrations(d, applied)
+}
+
+func resourceSQLMigrationsUpdateRetry(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	return projectReadiness.Retry(resourceSQLMigrationsUpdate, ctx, d, meta)
+}
+
+func resourceSQLMigrationsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+	tflog.Trace(ctx, "update SQL Migrations", map[string]interface{}{"id": d.Id()})
+
+	if !d.HasChanges("migrations", "dry_run_migrations") {
+		return nil
+	}
+	return resourceSQLMigrationsApply(ctx, d, meta)
+}
+
+func resourceSQLMigrationsDelete(ctx context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	tflog.Debug(ctx, "SQL migrations cannot be reverted, removing from state", map[string]interface{}{"id": d.Id()})
+	d.SetId("")
+	return nil
+}
+
+func resourceSQLMigrationsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) (
+	[]*schema.ResourceData, error,
+) {
+	tflog.Trace(ctx, "import SQL Migrations")
+
+	r, err := parseSQLObjectID(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	_ = d.Set("project_id", r.ProjectID)
+	_ = d.Set("branch_id", r.BranchID)
+	_ = d.Set("database", r.Database)
+	_ = d.Set("tracking_table", r.Name)
+	_ = d.Set("dry_run", false)
+
+	if diags := projectReadiness.Retry(resourceSQLMigrationsRead, ctx, d, meta); diags.HasError() {
+		d.SetId("")
+		return nil, errors.New(diags[0].Summary)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
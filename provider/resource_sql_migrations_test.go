@@ -0,0 +1,235 @@
+//go:build !acceptance
+// +build !acceptance
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+func writeTestSQLMigrations(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func sqlMigrationsVersions(v []sqlMigration) []string {
+	var o []string
+	for _, el := range v {
+		o = append(o, el.Version)
+	}
+	return o
+}
+
+func Test_listSQLMigrations(t *testing.T) {
+	dir := t.TempDir()
+	writeTestSQLMigrations(t, dir, map[string]string{
+		"0002_users.sql": "CREATE TABLE users (id int);",
+		"0001_init.sql":  "CREATE SCHEMA app;",
+		"README.md":      "migrations",
+	})
+
+	t.Run("shall list the sql files of the directory in the lexical order", func(t *testing.T) {
+		got, err := listSQLMigrations(dir, nil)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := []string{"0001_init", "0002_users"}; !reflect.DeepEqual(sqlMigrationsVersions(got), want) {
+			t.Errorf("unexpected versions: want=%v, got=%v", want, sqlMigrationsVersions(got))
+		}
+		if got[0].Checksum == "" || got[0].Checksum == got[1].Checksum {
+			t.Error("unexpected checksums")
+		}
+	})
+
+	t.Run("shall keep the order of the files", func(t *testing.T) {
+		got, err := listSQLMigrations("", []interface{}{
+			filepath.Join(dir, "0002_users.sql"), filepath.Join(dir, "0001_init.sql"),
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := []string{"0002_users", "0001_init"}; !reflect.DeepEqual(sqlMigrationsVersions(got), want) {
+			t.Errorf("unexpected versions: want=%v, got=%v", want, sqlMigrationsVersions(got))
+		}
+	})
+
+	t.Run("shall fail on duplicate versions", func(t *testing.T) {
+		if _, err := listSQLMigrations("", []interface{}{
+			filepath.Join(dir, "0001_init.sql"), filepath.Join(dir, "0001_init.sql"),
+		}); err == nil {
+			t.Error("error expected")
+		}
+	})
+
+	t.Run("shall fail if the file does not exist", func(t *testing.T) {
+		if _, err := listSQLMigrations("", []interface{}{filepath.Join(dir, "missing.sql")}); err == nil {
+			t.Error("error expected")
+		}
+	})
+}
+
+func Test_pendingSQLMigrations(t *testing.T) {
+	applied := []sqlMigration{{Version: "0001", Checksum: "foo"}}
+
+	t.Run("shall return the migrations which were not applied", func(t *testing.T) {
+		got, err := pendingSQLMigrations(applied, []sqlMigration{
+			{Version: "0001", Checksum: "foo"},
+			{Version: "0002", Checksum: "bar"},
+		})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if want := []string{"0002"}; !reflect.DeepEqual(sqlMigrationsVersions(got), want) {
+			t.Errorf("unexpected pending migrations: want=%v, got=%v", want, sqlMigrationsVersions(got))
+		}
+	})
+
+	t.Run("shall fail if the checksum of the applied migration changed", func(t *testing.T) {
+		if _, err := pendingSQLMigrations(applied, []sqlMigration{{Version: "0001", Checksum: "qux"}}); err == nil {
+			t.Error("error expected")
+		}
+	})
+}
+
+func Test_equalSQLMigrations(t *testing.T) {
+	v := []sqlMigration{{Version: "0001", Checksum: "foo", Path: "0001.sql"}}
+
+	if !equalSQLMigrations(v, []sqlMigration{{Version: "0001", Checksum: "foo"}}) {
+		t.Error("migrations with equal versions and checksums expected to be equal")
+	}
+	if equalSQLMigrations(v, []sqlMigration{{Version: "0001", Checksum: "bar"}}) {
+		t.Error("migrations with different checksums expected to differ")
+	}
+	if equalSQLMigrations(v, nil) {
+		t.Error("migrations of different length expected to differ")
+	}
+}
+
+func Test_dryRunSQLMigrations(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	meta := &sdkClientStub{
+		stubBranches: stubBranches{
+			Branches: []neon.Branch{{ID: "br-foo"}},
+		},
+	}
+
+	// the connection fails because no connection string is defined
+	err := dryRunSQLMigrations(context.TODO(), meta,
+		sqlTarget{ProjectID: "myproject", BranchID: "br-foo", Database: "neondb"}, defaultSQLMigrationsTrackingTable,
+		nil,
+	)
+	if err == nil {
+		t.Fatal("error expected")
+	}
+
+	if len(meta.Branches) != 1 || meta.Branches[0].ID != "br-foo" {
+		t.Errorf("temporary branch expected to be deleted, branches: %v", meta.Branches)
+	}
+}
+
+func Test_resourceSQLMigrations(t *testing.T) {
+	if os.Getenv("TF_ACC") == "1" {
+		t.Skip("acceptance tests are running")
+	}
+
+	meta := newTestSQLMeta(t)
+	t.Cleanup(func() {
+		execTestSQL(t, meta, `DROP TABLE IF EXISTS migrations_test_users`, `DROP TABLE IF EXISTS migrations_test_tracking`)
+	})
+
+	dir := t.TempDir()
+	writeTestSQLMigrations(t, dir, map[string]string{
+		"0001_users.sql": "CREATE TABLE migrations_test_users (id int); INSERT INTO migrations_test_users VALUES (1);",
+	})
+
+	definition := resourceSQLMigrations().TestResourceData()
+	_ = definition.Set("project_id", "myproject")
+	_ = definition.Set("branch_id", "br-foo")
+	_ = definition.Set("database", "postgres")
+	_ = definition.Set("dir", dir)
+	_ = definition.Set("tracking_table", "migrations_test_tracking")
+
+	if err := resourceSQLMigrationsCreate(context.TODO(), definition, meta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if want := "myproject/br-foo/postgres/migrations_test_tracking"; definition.Id() != want {
+		t.Errorf("unexpected resource ID: want=%s, got=%s", want, definition.Id())
+	}
+	if got, want := sqlMigrationsVersions(getSQLMigrationsState(definition)), []string{"0001_users"}; !reflect.DeepEqual(
+		got, want,
+	) {
+		t.Errorf("unexpected migrations: want=%v, got=%v", want, got)
+	}
+
+	t.Run("shall apply the pending migration only", func(t *testing.T) {
+		writeTestSQLMigrations(t, dir, map[string]string{
+			"0002_users_name.sql": "ALTER TABLE migrations_test_users ADD COLUMN name text;",
+		})
+		if err := resourceSQLMigrationsApply(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got, want := sqlMigrationsVersions(getSQLMigrationsState(definition)),
+			[]string{"0001_users", "0002_users_name"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected migrations: want=%v, got=%v", want, got)
+		}
+	})
+
+	t.Run("shall roll back the failed migration", func(t *testing.T) {
+		writeTestSQLMigrations(t, dir, map[string]string{
+			"0003_broken.sql": "ALTER TABLE migrations_test_users ADD COLUMN age int; SELECT foo FROM bar;",
+		})
+		t.Cleanup(func() { _ = os.Remove(filepath.Join(dir, "0003_broken.sql")) })
+
+		if err := resourceSQLMigrationsApply(context.TODO(), definition, meta); err == nil {
+			t.Fatal("error expected")
+		}
+		if err := resourceSQLMigrationsRead(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n := len(getSQLMigrationsState(definition)); n != 2 {
+			t.Errorf("failed migration shall not be recorded, got %d migrations", n)
+		}
+	})
+
+	t.Run("shall fail if the applied migration changed", func(t *testing.T) {
+		writeTestSQLMigrations(t, dir, map[string]string{
+			"0001_users.sql": "CREATE TABLE migrations_test_users (id bigint);",
+		})
+		if err := resourceSQLMigrationsApply(context.TODO(), definition, meta); err == nil {
+			t.Fatal("error expected")
+		}
+	})
+	t.Run("shall not record the dry-run migrations as applied", func(t *testing.T) {
+		writeTestSQLMigrations(t, dir, map[string]string{
+			"0001_users.sql":  "CREATE TABLE migrations_test_users (id int); INSERT INTO migrations_test_users VALUES (1);",
+			"0003_select.sql": "SELECT 1;",
+		})
+		_ = definition.Set("dry_run", true)
+
+		if err := resourceSQLMigrationsApply(context.TODO(), definition, meta); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if got, want := sqlMigrationsVersions(getSQLMigrationsState(definition)),
+			[]string{"0001_users", "0002_users_name"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected migrations: want=%v, got=%v", want, got)
+		}
+		if got, want := sqlMigrationsVersions(getSQLMigrationsAttr(definition, "dry_run_migrations")),
+			[]string{"0003_select"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("unexpected dry-run migrations: want=%v, got=%v", want, got)
+		}
+	})
+}
@@ -0,0 +1,247 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/jackc/pgx/v5"
+	neon "github.com/kislerdm/neon-sdk-go"
+)
+
+// sqlTarget defines the database to connect to in order to manage the Postgres objects.
+type sqlTarget struct {
+	ProjectID, BranchID, Database, Role string
+	// Pooled defines if the connection is established via the connection pooler.
+	Pooled bool
+	// ReadOnly defines if the transactions of the connection are read-only by default.
+	// The setting is not applied to the pooled connection because the pooler rejects the startup parameter.
+	ReadOnly bool
+}
+
+func newSQLTarget(d *schema.ResourceData) sqlTarget {
+	return sqlTarget{
+		ProjectID: d.Get("project_id").(string),
+		BranchID:  d.Get("branch_id").(string),
+		Database:  d.Get("database").(string),
+		Role:      d.Get("role_name").(string),
+	}
+}
+
+// newSchemaSQLTarget adds the attributes which define the database to connect to into the resource's schema.
+func newSchemaSQLTarget(s map[string]*schema.Schema) map[string]*schema.Schema {
+	s["project_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Project ID.",
+	}
+	s["branch_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Branch ID.",
+	}
+	s["database"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		ForceNew:    true,
+		Description: "Database name.",
+	}
+	s["role_name"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Optional: true,
+		Description: `Name of the role to connect to the database as. The database owner is used if not set.
+The role's password is read from the Neon API.`,
+	}
+	return s
+}
+
+// sqlObjectID is the identifier of the Postgres object in the database of the branch which follows the template:
+// {{.ProjectID}}/{{.BranchID}}/{{.Database}}/{{.Name}}.
+type sqlObjectID struct {
+	complexID
+	Database string
+}
+
+func newSQLObjectID(t sqlTarget, name string) sqlObjectID {
+	return sqlObjectID{
+		complexID: complexID{
+			ProjectID: t.ProjectID,
+			BranchID:  t.BranchID,
+			Name:      name,
+		},
+		Database: t.Database,
+	}
+}
+
+func (v sqlObjectID) toString() string {
+	return complexID{ProjectID: v.ProjectID, BranchID: v.BranchID, Name: v.Database}.toString() + "/" + v.Name
+}
+
+func parseSQLObjectID(s string) (sqlObjectID, error) {
+	spl := strings.SplitN(s, "/", 4)
+	if len(spl) != 4 || spl[3] == "" {
+		return sqlObjectID{}, errors.New(
+			"ID of this resource type shall follow the template: {{.ProjectID}}/{{.BranchID}}/{{.Database}}/{{.Name}}",
+		)
+	}
+
+	// the database is identified by the complex ID {{.ProjectID}}/{{.BranchID}}/{{.Database}}
+	db, err := parseComplexID(strings.Join(spl[:3], "/"))
+	if err != nil {
+		return sqlObjectID{}, err
+	}
+
+	return sqlObjectID{
+		complexID: complexID{
+			ProjectID: db.ProjectID,
+			BranchID:  db.BranchID,
+			Name:      spl[3],
+		},
+		Database: db.Name,
+	}, nil
+}
+
+func setResourceAttrsFromSQLObjectID(d *schema.ResourceData, r sqlObjectID) {
+	setResourceAttrsFromComplexID(d, r.complexID)
+	_ = d.Set("database", r.Database)
+}
+
+type sdkSQL interface {
+	ListProjectBranchEndpoints(projectID string, branchID string) (neon.EndpointsResponse, error)
+	GetProjectBranchDatabase(projectID string, branchID string, databaseName string) (neon.DatabaseResponse, error)
+	GetProjectBranchRolePassword(projectID string, branchID string, roleName string) (neon.RolePasswordResponse, error)
+}
+
+// sqlConnStringer defines the connection string to the database.
+// It allows to manage the Postgres objects in the database which is not hosted by Neon, e.g. in tests.
+type sqlConnStringer interface {
+	sqlConnString(t sqlTarget) (string, error)
+}
+
+func newSQLConnString(meta interface{}, t sqlTarget) (string, error) {
+	if c, ok := meta.(sqlConnStringer); ok {
+		return c.sqlConnString(t)
+	}
+	return newNeonSQLConnString(meta.(sdkSQL), t)
+}
+
+// newNeonSQLConnString defines the connection string to the database via the read-write endpoint of the branch.
+func newNeonSQLConnString(client sdkSQL, t sqlTarget) (string, error) {
+	endpoints, err := client.ListProjectBranchEndpoints(t.ProjectID, t.BranchID)
+	if err != nil {
+		return "", err
+	}
+
+	var host string
+	for _, v := range endpoints.Endpoints {
+		if v.Type == endpointTypeRW {
+			host = v.Host
+			break
+		}
+	}
+	if host == "" {
+		return "", fmt.Errorf("no read-write endpoint found for the branch %s of the project %s",
+			t.BranchID, t.ProjectID)
+	}
+	if t.Pooled {
+		host = newPooledHost(host)
+	}
+
+	role := t.Role
+	if role == "" {
+		db, err := client.GetProjectBranchDatabase(t.ProjectID, t.BranchID, t.Database)
+		if err != nil {
+			return "", err
+		}
+		role = db.Database.OwnerName
+	}
+
+	pass, err := client.GetProjectBranchRolePassword(t.ProjectID, t.BranchID, role)
+	if err != nil {
+		return "", err
+	}
+
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(role, pass.Password),
+		Host:     host,
+		Path:     "/" + t.Database,
+		RawQuery: "sslmode=require",
+	}
+	return u.String(), nil
+}
+
+// withSQLConn connects to the database, executes fn and closes the connection.
+func withSQLConn(ctx context.Context, meta interface{}, t sqlTarget, fn func(conn *pgx.Conn) error) error {
+	dsn, err := newSQLConnString(meta, t)
+	if err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "connect to the database", map[string]interface{}{
+		"project_id": t.ProjectID,
+		"branch_id":  t.BranchID,
+		"database":   t.Database,
+	})
+	cfg, err := pgx.ParseConfig(dsn)
+	if err != nil {
+		return err
+	}
+	if t.ReadOnly && !t.Pooled {
+		cfg.RuntimeParams["default_transaction_read_only"] = "on"
+	}
+
+	conn, err := pgx.ConnectConfig(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = conn.Close(context.Background()) }()
+
+	return fn(conn)
+}
+
+// retrySQLRead reads the Postgres object, and removes it from the state if its branch, or database does not exist.
+func retrySQLRead(
+	fn func(context.Context, *schema.ResourceData, interface{}) error,
+	ctx context.Context, d *schema.ResourceData, meta interface{},
+) diag.Diagnostics {
+	return projectReadiness.RetryWithFallback(fn, ctx, d, meta, map[int]FallbackFn{
+		http.StatusNotFound: func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			tflog.Debug(ctx, "branch or database not found, removing from state",
+				map[string]interface{}{"id": d.Id()})
+			d.SetId("")
+			return nil
+		}})
+}
+
+// retrySQLDelete drops the Postgres object, and succeeds if its branch, or database does not exist.
+func retrySQLDelete(
+	fn func(context.Context, *schema.ResourceData, interface{}) error,
+	ctx context.Context, d *schema.ResourceData, meta interface{},
+) diag.Diagnostics {
+	return projectReadiness.RetryWithFallback(fn, ctx, d, meta, map[int]FallbackFn{
+		http.StatusNotFound: func(ctx context.Context, d *schema.ResourceData, meta interface{}) error {
+			tflog.Debug(ctx, "branch or database not found, removing from state",
+				map[string]interface{}{"id": d.Id()})
+			d.SetId("")
+			return nil
+		}})
+}
+
+// quoteIdentifier quotes the SQL identifier, e.g. the name of the schema.
+func quoteIdentifier(s string) string {
+	return pgx.Identifier{s}.Sanitize()
+}
+
+// quoteLiteral quotes the SQL string literal.
+func quoteLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
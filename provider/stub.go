@@ -1,6 +1,9 @@
 package provider
 
 import (
+	"errors"
+	"net/http"
+	"strconv"
 	"sync"
 	"time"
 
@@ -12,17 +15,48 @@ type sdkClientStub struct {
 	stubProjectPermission
 	stubProjectRolePassword
 	stubVPCEndpoint
+	stubSnapshot
+	stubOrganization
+	stubProjects
+	stubBranches
+	stubEndpoint
+	stubConsumption
+	stubLimits
+	stubOperations
+	stubNeonAuth
+	stubDataAPI
+	stubJwks
+	stubDatabases
+	stubSQL
 	mockOpsReader
 
+	// project defines the project returned by GetProject.
+	project neon.Project
+
 	req interface{}
 	err error
 }
 
 type stubVPCEndpoint struct {
 	VPCEndpointDetails neon.VPCEndpointDetails
+	VPCEndpoints       []neon.VPCEndpoint
 	err                error
 }
 
+func (s *stubVPCEndpoint) ListOrganizationVPCEndpoints(_, _ string) (neon.VPCEndpointsResponse, error) {
+	if s.err != nil {
+		return neon.VPCEndpointsResponse{}, s.err
+	}
+	return neon.VPCEndpointsResponse{Endpoints: s.VPCEndpoints}, nil
+}
+
+func (s *stubVPCEndpoint) ListProjectVPCEndpoints(_ string) (neon.VPCEndpointsResponse, error) {
+	if s.err != nil {
+		return neon.VPCEndpointsResponse{}, s.err
+	}
+	return neon.VPCEndpointsResponse{Endpoints: s.VPCEndpoints}, nil
+}
+
 func (s *stubVPCEndpoint) AssignOrganizationVPCEndpoint(_, _, _ string, _ neon.VPCEndpointAssignment) error {
 	return s.err
 }
@@ -38,28 +72,586 @@ func (s *stubVPCEndpoint) DeleteOrganizationVPCEndpoint(_, _, _ string) error {
 	return s.err
 }
 
-func (s *sdkClientStub) UpdateProject(_ string, cfg neon.ProjectUpdateRequest) (neon.UpdateProjectRespObj, error) {
-	s.req = cfg
-	return neon.UpdateProjectRespObj{}, s.err
+type stubSnapshot struct {
+	Snapshots []neon.Snapshot
+	err       error
 }
 
-func (s *sdkClientStub) GetProject(_ string) (neon.ProjectResponse, error) {
-	return neon.ProjectResponse{}, nil
+func (s *stubSnapshot) CreateSnapshot(_ string, branchID string, lsn *string, timestamp *string, name *string,
+	expiresAt *string) (neon.CreateSnapshotRespObj, error) {
+	if s.err != nil {
+		return neon.CreateSnapshotRespObj{}, s.err
+	}
+
+	snapshot := neon.Snapshot{
+		CreatedAt:      time.Now().UTC().Format(time.RFC3339),
+		ExpiresAt:      expiresAt,
+		ID:             uuid.NewString(),
+		Lsn:            lsn,
+		Manual:         pointer(true),
+		SourceBranchID: &branchID,
+		Timestamp:      timestamp,
+	}
+	if name != nil {
+		snapshot.Name = *name
+	}
+
+	s.Snapshots = append(s.Snapshots, snapshot)
+	return neon.CreateSnapshotRespObj{Snapshot: snapshot}, nil
 }
 
-func (s *sdkClientStub) ListProjectBranches(_ string, _ *string, _ *string, _ *string, _ *string, _ *int) (neon.ListProjectBranchesRespObj, error) {
-	return neon.ListProjectBranchesRespObj{}, nil
+func (s *stubSnapshot) ListSnapshots(_ string) (neon.ListSnapshotsRespObj, error) {
+	if s.err != nil {
+		return neon.ListSnapshotsRespObj{}, s.err
+	}
+	return neon.ListSnapshotsRespObj{Snapshots: s.Snapshots}, nil
 }
 
-func (s *sdkClientStub) ListProjectBranchEndpoints(_ string, _ string) (neon.EndpointsResponse, error) {
-	panic("implement me")
+func (s *stubSnapshot) UpdateSnapshot(_ string, snapshotID string, cfg neon.SnapshotUpdateRequest) (
+	neon.UpdateSnapshotRespObj, error) {
+	if s.err != nil {
+		return neon.UpdateSnapshotRespObj{}, s.err
+	}
+	for i, snapshot := range s.Snapshots {
+		if snapshot.ID == snapshotID {
+			s.Snapshots[i].Name = *cfg.Snapshot.Name
+			return neon.UpdateSnapshotRespObj{Snapshot: s.Snapshots[i]}, nil
+		}
+	}
+	return neon.UpdateSnapshotRespObj{}, neon.Error{HTTPCode: http.StatusNotFound}
 }
 
-func (s *sdkClientStub) DeleteProject(_ string) (neon.ProjectResponse, error) {
-	panic("implement me")
+func (s *stubSnapshot) DeleteSnapshot(_ string, snapshotID string) error {
+	if s.err != nil {
+		return s.err
+	}
+	for i, snapshot := range s.Snapshots {
+		if snapshot.ID == snapshotID {
+			s.Snapshots = append(s.Snapshots[:i], s.Snapshots[i+1:]...)
+			return nil
+		}
+	}
+	return neon.Error{HTTPCode: http.StatusNotFound}
+}
+
+type stubOrganization struct {
+	Organization neon.Organization
+	Members      []neon.MemberWithUser
+	Invitations  []neon.Invitation
+	err          error
+}
+
+func (s *stubOrganization) GetOrganization(_ string) (neon.Organization, error) {
+	if s.err != nil {
+		return neon.Organization{}, s.err
+	}
+	return s.Organization, nil
 }
 
-func (s *sdkClientStub) ListProjectBranchDatabases(_ string, _ string) (neon.DatabasesResponse, error) {
+func (s *stubOrganization) GetOrganizationMembers(_ string) (neon.OrganizationMembersResponse, error) {
+	if s.err != nil {
+		return neon.OrganizationMembersResponse{}, s.err
+	}
+	return neon.OrganizationMembersResponse{Members: s.Members}, nil
+}
+
+func (s *stubOrganization) UpdateOrganizationMember(_ string, memberID string,
+	cfg neon.OrganizationMemberUpdateRequest) (neon.Member, error) {
+	if s.err != nil {
+		return neon.Member{}, s.err
+	}
+	for i, member := range s.Members {
+		if member.Member.ID == memberID {
+			s.Members[i].Member.Role = cfg.Role
+			return s.Members[i].Member, nil
+		}
+	}
+	return neon.Member{}, neon.Error{HTTPCode: http.StatusNotFound}
+}
+
+func (s *stubOrganization) RemoveOrganizationMember(_ string, memberID string) (neon.EmptyResponse, error) {
+	if s.err != nil {
+		return neon.EmptyResponse{}, s.err
+	}
+	for i, member := range s.Members {
+		if member.Member.ID == memberID {
+			s.Members = append(s.Members[:i], s.Members[i+1:]...)
+			return neon.EmptyResponse{}, nil
+		}
+	}
+	return neon.EmptyResponse{}, neon.Error{HTTPCode: http.StatusNotFound}
+}
+
+func (s *stubOrganization) GetOrganizationInvitations(_ string) (neon.OrganizationInvitationsResponse, error) {
+	if s.err != nil {
+		return neon.OrganizationInvitationsResponse{}, s.err
+	}
+	return neon.OrganizationInvitationsResponse{Invitations: s.Invitations}, nil
+}
+
+func (s *stubOrganization) CreateOrganizationInvitations(orgID string, cfg neon.OrganizationInvitesCreateRequest) (
+	neon.OrganizationInvitationsResponse, error) {
+	if s.err != nil {
+		return neon.OrganizationInvitationsResponse{}, s.err
+	}
+
+	var o neon.OrganizationInvitationsResponse
+	for _, v := range cfg.Invitations {
+		invitation := neon.Invitation{
+			Email:     v.Email,
+			ID:        uuid.NewString(),
+			InvitedAt: time.Now().UTC(),
+			OrgID:     orgID,
+			Role:      v.Role,
+		}
+		s.Invitations = append(s.Invitations, invitation)
+		o.Invitations = append(o.Invitations, invitation)
+	}
+	return o, nil
+}
+
+type stubProjects struct {
+	Projects []neon.ProjectListItem
+	// requests records the number of the ListProjects calls.
+	requests int
+}
+
+// ListProjects returns the page of projects following the one with the ID equal to the cursor.
+func (s *stubProjects) ListProjects(cursor *string, limit *int, _ *string, _ *string, _ *int) (
+	neon.ListProjectsRespObj, error) {
+	s.requests++
+
+	var start int
+	if cursor != nil {
+		for i, v := range s.Projects {
+			if v.ID == *cursor {
+				start = i + 1
+				break
+			}
+		}
+	}
+
+	end := len(s.Projects)
+	if limit != nil && start+*limit < end {
+		end = start + *limit
+	}
+
+	var o neon.ListProjectsRespObj
+	o.Projects = s.Projects[start:end]
+	if len(o.Projects) > 0 {
+		o.Pagination = &neon.Pagination{Cursor: o.Projects[len(o.Projects)-1].ID}
+	}
+	return o, nil
+}
+
+type stubBranches struct {
+	Branches []neon.Branch
+	// PageSize defines the max number of branches returned per page, no limit if zero.
+	PageSize int
+	// branchesRequests records the number of the ListProjectBranches calls.
+	branchesRequests int
+	// branchesQuery records the search and the sorting arguments of the last ListProjectBranches call.
+	branchesQuery branchesQuery
+}
+
+// ListProjectBranches returns the page of branches following the one with the ID equal to the cursor.
+func (s *stubBranches) ListProjectBranches(_ string, search *string, sortBy *string, cursor *string,
+	sortOrder *string, limit *int) (neon.ListProjectBranchesRespObj, error) {
+	s.branchesRequests++
+	s.branchesQuery = branchesQuery{}
+	if search != nil {
+		s.branchesQuery.Search = *search
+	}
+	if sortBy != nil {
+		s.branchesQuery.SortBy = *sortBy
+	}
+	if sortOrder != nil {
+		s.branchesQuery.SortOrder = *sortOrder
+	}
+
+	var start int
+	if cursor != nil {
+		for i, v := range s.Branches {
+			if v.ID == *cursor {
+				start = i + 1
+				break
+			}
+		}
+	}
+
+	end := len(s.Branches)
+	if limit != nil && start+*limit < end {
+		end = start + *limit
+	}
+	if s.PageSize > 0 && start+s.PageSize < end {
+		end = start + s.PageSize
+	}
+
+	var o neon.ListProjectBranchesRespObj
+	o.Branches = s.Branches[start:end]
+	if end < len(s.Branches) {
+		o.Pagination = &neon.CursorPagination{Next: pointer(s.Branches[end-1].ID)}
+	}
+	return o, nil
+}
+
+// CreateProjectBranch appends the branch to the list of branches.
+func (s *stubBranches) CreateProjectBranch(_ string, cfg *neon.CreateProjectBranchReqObj) (neon.CreatedBranch, error) {
+	v := neon.Branch{ID: "br-" + strconv.Itoa(len(s.Branches))}
+	if cfg != nil && cfg.Branch != nil {
+		if cfg.Branch.Name != nil {
+			v.Name = *cfg.Branch.Name
+		}
+		v.ParentID = cfg.Branch.ParentID
+	}
+	s.Branches = append(s.Branches, v)
+	return neon.CreatedBranch{BranchResponse: neon.BranchResponse{Branch: v}}, nil
+}
+
+// DeleteProjectBranch removes the branch from the list of branches.
+func (s *stubBranches) DeleteProjectBranch(_ string, branchID string) (neon.BranchOperations, error) {
+	for i, v := range s.Branches {
+		if v.ID == branchID {
+			s.Branches = append(s.Branches[:i], s.Branches[i+1:]...)
+			return neon.BranchOperations{BranchResponse: neon.BranchResponse{Branch: v}}, nil
+		}
+	}
+	return neon.BranchOperations{}, neon.Error{HTTPCode: http.StatusNotFound}
+}
+
+type stubEndpoint struct {
+	Endpoint neon.Endpoint
+	err      error
+}
+
+func (s *stubEndpoint) ListProjectBranchEndpoints(_ string, branchID string) (neon.EndpointsResponse, error) {
+	if s.err != nil {
+		return neon.EndpointsResponse{}, s.err
+	}
+	var o neon.EndpointsResponse
+	if s.Endpoint.ID != "" && s.Endpoint.BranchID == branchID {
+		o.Endpoints = append(o.Endpoints, s.Endpoint)
+	}
+	return o, nil
+}
+
+func (s *stubEndpoint) GetProjectEndpoint(_ string, endpointID string) (neon.EndpointResponse, error) {
+	if s.err != nil {
+		return neon.EndpointResponse{}, s.err
+	}
+	if s.Endpoint.ID != endpointID {
+		return neon.EndpointResponse{}, neon.Error{HTTPCode: http.StatusNotFound}
+	}
+	return neon.EndpointResponse{Endpoint: s.Endpoint}, nil
+}
+
+type stubConsumption struct {
+	Periods []neon.ConsumptionHistoryPerPeriod
+	err     error
+}
+
+func (s *stubConsumption) GetConsumptionHistoryPerAccount(_ time.Time, _ time.Time,
+	_ neon.ConsumptionHistoryGranularity, _ *string, _ *bool, _ []string) (
+	neon.ConsumptionHistoryPerAccountResponse, error) {
+	if s.err != nil {
+		return neon.ConsumptionHistoryPerAccountResponse{}, s.err
+	}
+	return neon.ConsumptionHistoryPerAccountResponse{Periods: s.Periods}, nil
+}
+
+func (s *stubConsumption) GetConsumptionHistoryPerProject(_ *string, _ *int, projectIDs []string, _ time.Time,
+	_ time.Time, _ neon.ConsumptionHistoryGranularity, _ *string, _ *bool, _ []string) (
+	neon.GetConsumptionHistoryPerProjectRespObj, error) {
+	if s.err != nil {
+		return neon.GetConsumptionHistoryPerProjectRespObj{}, s.err
+	}
+
+	var o neon.GetConsumptionHistoryPerProjectRespObj
+	for _, projectID := range projectIDs {
+		o.Projects = append(o.Projects, neon.ConsumptionHistoryPerProject{ProjectID: projectID, Periods: s.Periods})
+	}
+	return o, nil
+}
+
+type stubLimits struct {
+	CurrentUserInfo neon.CurrentUserInfoResponse
+	Regions         []neon.RegionResponse
+	err             error
+}
+
+func (s *stubLimits) GetCurrentUserInfo() (neon.CurrentUserInfoResponse, error) {
+	if s.err != nil {
+		return neon.CurrentUserInfoResponse{}, s.err
+	}
+	return s.CurrentUserInfo, nil
+}
+
+func (s *stubLimits) GetActiveRegions() (neon.ActiveRegionsResponse, error) {
+	if s.err != nil {
+		return neon.ActiveRegionsResponse{}, s.err
+	}
+	return neon.ActiveRegionsResponse{Regions: s.Regions}, nil
+}
+
+type stubOperations struct {
+	Operations []neon.Operation
+	err        error
+}
+
+// ListProjectOperations returns the page of operations following the one with the ID equal to the cursor.
+func (s *stubOperations) ListProjectOperations(_ string, cursor *string, limit *int) (neon.ListOperations, error) {
+	if s.err != nil {
+		return neon.ListOperations{}, s.err
+	}
+
+	var start int
+	if cursor != nil {
+		for i, v := range s.Operations {
+			if v.ID == *cursor {
+				start = i + 1
+				break
+			}
+		}
+	}
+
+	end := len(s.Operations)
+	if limit != nil && start+*limit < end {
+		end = start + *limit
+	}
+
+	var o neon.ListOperations
+	o.Operations = s.Operations[start:end]
+	if len(o.Operations) > 0 {
+		o.Pagination = &neon.Pagination{Cursor: o.Operations[len(o.Operations)-1].ID}
+	}
+	return o, nil
+}
+
+type stubNeonAuth struct {
+	Integrations []neon.NeonAuthIntegration
+	err          error
+}
+
+func (s *stubNeonAuth) CreateNeonAuthIntegration(cfg neon.NeonAuthCreateIntegrationRequest) (
+	neon.NeonAuthCreateIntegrationResponse, error) {
+	if s.err != nil {
+		return neon.NeonAuthCreateIntegrationResponse{}, s.err
+	}
+
+	integration := neon.NeonAuthIntegration{
+		AuthProvider:          cfg.AuthProvider,
+		AuthProviderProjectID: uuid.NewString(),
+		BranchID:              cfg.BranchID,
+		CreatedAt:             time.Now().UTC(),
+		DbName:                "neondb",
+		JwksURL:               "https://api.stack-auth.com/api/v1/projects/foo/.well-known/jwks.json",
+	}
+	if cfg.DatabaseName != nil {
+		integration.DbName = *cfg.DatabaseName
+	}
+	s.Integrations = append(s.Integrations, integration)
+
+	return neon.NeonAuthCreateIntegrationResponse{
+		AuthProvider:          integration.AuthProvider,
+		AuthProviderProjectID: integration.AuthProviderProjectID,
+		JwksURL:               integration.JwksURL,
+		PubClientKey:          "pck_foo",
+		SchemaName:            "neon_auth",
+		SecretServerKey:       "ssk_foo",
+		TableName:             "users_sync",
+	}, nil
+}
+
+func (s *stubNeonAuth) ListNeonAuthIntegrations(_ string) (neon.ListNeonAuthIntegrationsResponse, error) {
+	if s.err != nil {
+		return neon.ListNeonAuthIntegrationsResponse{}, s.err
+	}
+	return neon.ListNeonAuthIntegrationsResponse{Data: s.Integrations}, nil
+}
+
+func (s *stubNeonAuth) DeleteNeonAuthIntegration(_ string, authProvider neon.NeonAuthSupportedAuthProvider,
+	_ *neon.DeleteNeonAuthIntegrationReqObj) error {
+	if s.err != nil {
+		return s.err
+	}
+	for i, v := range s.Integrations {
+		if v.AuthProvider == authProvider {
+			s.Integrations = append(s.Integrations[:i], s.Integrations[i+1:]...)
+			return nil
+		}
+	}
+	return neon.Error{HTTPCode: http.StatusNotFound}
+}
+
+type stubDataAPI struct {
+	DataAPIs map[string]neon.DataAPIReponse
+	err      error
+}
+
+func (s *stubDataAPI) CreateProjectBranchDataAPI(projectID string, branchID string, databaseName string,
+	_ *neon.DataAPICreateRequest) (neon.DataAPICreateResponse, error) {
+	if s.err != nil {
+		return neon.DataAPICreateResponse{}, s.err
+	}
+	if s.DataAPIs == nil {
+		s.DataAPIs = map[string]neon.DataAPIReponse{}
+	}
+	id := complexID{ProjectID: projectID, BranchID: branchID, Name: databaseName}.toString()
+	v := neon.DataAPIReponse{Status: "ready", URL: "https://" + branchID + ".dataapi.neon.tech/" + databaseName}
+	s.DataAPIs[id] = v
+	return neon.DataAPICreateResponse{URL: v.URL}, nil
+}
+
+func (s *stubDataAPI) GetProjectBranchDataAPI(projectID string, branchID string, databaseName string) (
+	neon.DataAPIReponse, error) {
+	if s.err != nil {
+		return neon.DataAPIReponse{}, s.err
+	}
+	v, ok := s.DataAPIs[complexID{ProjectID: projectID, BranchID: branchID, Name: databaseName}.toString()]
+	if !ok {
+		return neon.DataAPIReponse{}, neon.Error{HTTPCode: http.StatusNotFound}
+	}
+	return v, nil
+}
+
+func (s *stubDataAPI) DeleteProjectBranchDataAPI(projectID string, branchID string, databaseName string) (
+	neon.EmptyResponse, error) {
+	if s.err != nil {
+		return neon.EmptyResponse{}, s.err
+	}
+	id := complexID{ProjectID: projectID, BranchID: branchID, Name: databaseName}.toString()
+	if _, ok := s.DataAPIs[id]; !ok {
+		return neon.EmptyResponse{}, neon.Error{HTTPCode: http.StatusNotFound}
+	}
+	delete(s.DataAPIs, id)
+	return neon.EmptyResponse{}, nil
+}
+
+type stubJwks struct {
+	Jwks []neon.JWKS
+	err  error
+	// rejectDuplicateJwksURL defines if the registration of the registered JWKS URL fails.
+	rejectDuplicateJwksURL bool
+	// errAdd defines the error of the JWKS registration.
+	errAdd func(cfg neon.AddProjectJWKSRequest) error
+	// errDelete defines the error of the JWKS deletion by the JWKS ID.
+	errDelete func(jwksID string) error
+}
+
+func (s *stubJwks) AddProjectJWKS(projectID string, cfg neon.AddProjectJWKSRequest) (neon.JWKSCreationOperation,
+	error) {
+	if s.err != nil {
+		return neon.JWKSCreationOperation{}, s.err
+	}
+	if s.errAdd != nil {
+		if err := s.errAdd(cfg); err != nil {
+			return neon.JWKSCreationOperation{}, err
+		}
+	}
+	if s.rejectDuplicateJwksURL {
+		for _, v := range s.Jwks {
+			if v.JwksURL == cfg.JwksURL {
+				return neon.JWKSCreationOperation{}, neon.Error{HTTPCode: http.StatusConflict}
+			}
+		}
+	}
+	v := neon.JWKS{
+		BranchID:     cfg.BranchID,
+		CreatedAt:    time.Now().UTC(),
+		ID:           uuid.NewString(),
+		JwksURL:      cfg.JwksURL,
+		JwtAudience:  cfg.JwtAudience,
+		ProjectID:    projectID,
+		ProviderName: cfg.ProviderName,
+		RoleNames:    cfg.RoleNames,
+	}
+	s.Jwks = append(s.Jwks, v)
+	return neon.JWKSCreationOperation{JWKSResponse: neon.JWKSResponse{Jwks: v}}, nil
+}
+
+func (s *stubJwks) GetProjectJWKS(_ string) (neon.ProjectJWKSResponse, error) {
+	if s.err != nil {
+		return neon.ProjectJWKSResponse{}, s.err
+	}
+	return neon.ProjectJWKSResponse{Jwks: s.Jwks}, nil
+}
+
+func (s *stubJwks) DeleteProjectJWKS(_ string, jwksID string) (neon.JWKS, error) {
+	if s.err != nil {
+		return neon.JWKS{}, s.err
+	}
+	if s.errDelete != nil {
+		if err := s.errDelete(jwksID); err != nil {
+			return neon.JWKS{}, err
+		}
+	}
+	for i, v := range s.Jwks {
+		if v.ID == jwksID {
+			s.Jwks = append(s.Jwks[:i], s.Jwks[i+1:]...)
+			return v, nil
+		}
+	}
+	return neon.JWKS{}, neon.Error{HTTPCode: http.StatusNotFound}
+}
+
+type stubDatabases struct {
+	Databases []neon.Database
+	err       error
+}
+
+func (s *stubDatabases) ListProjectBranchDatabases(_ string, branchID string) (neon.DatabasesResponse, error) {
+	if s.err != nil {
+		return neon.DatabasesResponse{}, s.err
+	}
+	var o neon.DatabasesResponse
+	for _, v := range s.Databases {
+		if v.BranchID == branchID {
+			o.Databases = append(o.Databases, v)
+		}
+	}
+	return o, nil
+}
+
+func (s *stubDatabases) GetProjectBranchDatabase(_ string, branchID string, databaseName string) (
+	neon.DatabaseResponse, error) {
+	if s.err != nil {
+		return neon.DatabaseResponse{}, s.err
+	}
+	for _, v := range s.Databases {
+		if v.BranchID == branchID && v.Name == databaseName {
+			return neon.DatabaseResponse{Database: v}, nil
+		}
+	}
+	return neon.DatabaseResponse{}, neon.Error{HTTPCode: http.StatusNotFound}
+}
+
+// stubSQL connects to the database defined by the connection string,
+// e.g. the local Postgres instance defined by the environment variable TEST_POSTGRES_DSN.
+type stubSQL struct {
+	ConnString string
+	err        error
+}
+
+func (s *stubSQL) sqlConnString(_ sqlTarget) (string, error) {
+	if s.err != nil {
+		return "", s.err
+	}
+	if s.ConnString == "" {
+		return "", errors.New("no connection string defined")
+	}
+	return s.ConnString, nil
+}
+
+func (s *sdkClientStub) UpdateProject(_ string, cfg neon.ProjectUpdateRequest) (neon.UpdateProjectRespObj, error) {
+	s.req = cfg
+	return neon.UpdateProjectRespObj{}, s.err
+}
+
+func (s *sdkClientStub) GetProject(_ string) (neon.ProjectResponse, error) {
+	return neon.ProjectResponse{Project: s.project}, nil
+}
+
+func (s *sdkClientStub) DeleteProject(_ string) (neon.ProjectResponse, error) {
 	panic("implement me")
 }
 
@@ -111,6 +703,15 @@ func (s *stubProjectPermission) RevokePermissionFromProject(_ string, permission
 		return neon.ProjectPermission{}, s.err
 	}
 
+	for i, permission := range s.ProjectPermissions.ProjectPermissions {
+		if permission.ID == permissionID {
+			s.ProjectPermissions.ProjectPermissions = append(
+				s.ProjectPermissions.ProjectPermissions[:i], s.ProjectPermissions.ProjectPermissions[i+1:]...,
+			)
+			break
+		}
+	}
+
 	now := time.Now().UTC()
 	return neon.ProjectPermission{
 		GrantedAt:      now.Add(-1 * time.Second),